@@ -0,0 +1,56 @@
+package collection
+
+// At returns the item at the given index and whether it exists.
+// Negative indices count from the end of the list, so At(list, -1) is the last item.
+func At[T any](source []T, index int) (T, bool) {
+	if index < 0 {
+		index += len(source)
+	}
+	if index < 0 || index >= len(source) {
+		var zero T
+		return zero, false
+	}
+	return source[index], true
+}
+
+// SafeSlice returns a copy of source[from:to] with both bounds clamped to the list,
+// returning an empty list instead of panicking when the range is out of bounds.
+func SafeSlice[T any](source []T, from, to int) []T {
+	from = clampIndex(from, len(source))
+	to = clampIndex(to, len(source))
+	if from >= to {
+		return []T{}
+	}
+	return CloneList(source[from:to])
+}
+
+// clampIndex limits index to the range [0, length].
+func clampIndex(index, length int) int {
+	if index < 0 {
+		return 0
+	}
+	if index > length {
+		return length
+	}
+	return index
+}
+
+// First returns the first item of the list and whether the list is non-empty.
+func First[T any](source []T) (T, bool) {
+	return At(source, 0)
+}
+
+// Last returns the last item of the list and whether the list is non-empty.
+func Last[T any](source []T) (T, bool) {
+	return At(source, -1)
+}
+
+// Tail returns a copy of the list without its first item.
+func Tail[T any](source []T) []T {
+	return SafeSlice(source, 1, len(source))
+}
+
+// Init returns a copy of the list without its last item.
+func Init[T any](source []T) []T {
+	return SafeSlice(source, 0, len(source)-1)
+}
@@ -0,0 +1,105 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAt(t *testing.T) {
+	source := []string{"a", "b", "c"}
+
+	tests := []struct {
+		name     string
+		index    int
+		expected string
+		ok       bool
+	}{
+		{name: "first", index: 0, expected: "a", ok: true},
+		{name: "last", index: 2, expected: "c", ok: true},
+		{name: "negative from end", index: -1, expected: "c", ok: true},
+		{name: "negative first", index: -3, expected: "a", ok: true},
+		{name: "out of range", index: 3, expected: "", ok: false},
+		{name: "negative out of range", index: -4, expected: "", ok: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			result, ok := At(source, tc.index)
+
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+
+	t.Run("nil list", func(t *testing.T) {
+		_, ok := At([]int(nil), 0)
+
+		assert.False(t, ok)
+	})
+}
+
+func TestSafeSlice(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5}
+
+	tests := []struct {
+		name     string
+		from     int
+		to       int
+		expected []int
+	}{
+		{name: "within bounds", from: 1, to: 3, expected: []int{2, 3}},
+		{name: "to beyond length", from: 3, to: 10, expected: []int{4, 5}},
+		{name: "negative from", from: -2, to: 2, expected: []int{1, 2}},
+		{name: "from after to", from: 4, to: 2, expected: []int{}},
+		{name: "from beyond length", from: 7, to: 9, expected: []int{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SafeSlice(source, tc.from, tc.to))
+		})
+	}
+}
+
+func TestFirstAndLast(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		source := []int{1, 2, 3}
+
+		first, ok := First(source)
+		assert.True(t, ok)
+		assert.Equal(t, 1, first)
+
+		last, ok := Last(source)
+		assert.True(t, ok)
+		assert.Equal(t, 3, last)
+	})
+
+	t.Run("Empty_list", func(t *testing.T) {
+		_, ok := First([]int{})
+		assert.False(t, ok)
+
+		_, ok = Last([]int{})
+		assert.False(t, ok)
+	})
+}
+
+func TestTailAndInit(t *testing.T) {
+	tests := []struct {
+		name         string
+		source       []int
+		expectedTail []int
+		expectedInit []int
+	}{
+		{name: "several items", source: []int{1, 2, 3}, expectedTail: []int{2, 3}, expectedInit: []int{1, 2}},
+		{name: "single item", source: []int{1}, expectedTail: []int{}, expectedInit: []int{}},
+		{name: "empty list", source: []int{}, expectedTail: []int{}, expectedInit: []int{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedTail, Tail(tc.source))
+			assert.Equal(t, tc.expectedInit, Init(tc.source))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package collection
+
+// AsCmpFunc converts a value-based less function into a three-way comparator
+// usable with slices.SortFunc, slices.BinarySearchFunc and friends.
+func AsCmpFunc[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		if less(a, b) {
+			return -1
+		}
+		if less(b, a) {
+			return 1
+		}
+		return 0
+	}
+}
+
+// AsLessFunc converts a three-way comparator written for the slices package into a value-based less function.
+func AsLessFunc[T any](cmpFunc func(a, b T) int) func(a, b T) bool {
+	return func(a, b T) bool { return cmpFunc(a, b) < 0 }
+}
+
+// AsEqualFunc converts a three-way comparator written for the slices package into an equality function,
+// e.g. for DistinctFunc or slices.EqualFunc.
+func AsEqualFunc[T any](cmpFunc func(a, b T) int) func(a, b T) bool {
+	return func(a, b T) bool { return cmpFunc(a, b) == 0 }
+}
+
+// FromSlicesSortFunc converts a three-way comparator written for slices.SortFunc into
+// the index-based less function expected by Sort for the given list.
+func FromSlicesSortFunc[T any](list []T, cmpFunc func(a, b T) int) func(i, j int) bool {
+	return func(i, j int) bool { return cmpFunc(list[i], list[j]) < 0 }
+}
@@ -0,0 +1,56 @@
+package collection
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAsCmpFunc(t *testing.T) {
+	t.Run("Success_slices_sort", func(t *testing.T) {
+		source := []string{"banana", "Apple", "cherry"}
+
+		slices.SortFunc(source, AsCmpFunc(func(a, b string) bool { return strings.ToLower(a) < strings.ToLower(b) }))
+
+		assert.Equal(t, []string{"Apple", "banana", "cherry"}, source)
+	})
+
+	t.Run("Success_three_way_result", func(t *testing.T) {
+		cmpFunc := AsCmpFunc(func(a, b int) bool { return a < b })
+
+		assert.Equal(t, -1, cmpFunc(1, 2))
+		assert.Equal(t, 1, cmpFunc(2, 1))
+		assert.Equal(t, 0, cmpFunc(2, 2))
+	})
+}
+
+func TestAsLessFuncAndAsEqualFunc(t *testing.T) {
+	less := AsLessFunc(cmp.Compare[int])
+	equal := AsEqualFunc(cmp.Compare[int])
+
+	assert.True(t, less(1, 2))
+	assert.False(t, less(2, 2))
+	assert.True(t, equal(2, 2))
+	assert.False(t, equal(1, 2))
+}
+
+func TestFromSlicesSortFunc(t *testing.T) {
+	t.Run("Success_sort_with_cmp", func(t *testing.T) {
+		source := []int{5, 2, 8, 1}
+
+		result := Sort(source, FromSlicesSortFunc(source, cmp.Compare[int]))
+
+		assert.Equal(t, []int{1, 2, 5, 8}, result)
+	})
+
+	t.Run("Success_reverse_cmp", func(t *testing.T) {
+		source := []string{"a", "c", "b"}
+
+		result := Sort(source, FromSlicesSortFunc(source, func(a, b string) int { return strings.Compare(b, a) }))
+
+		assert.Equal(t, []string{"c", "b", "a"}, result)
+	})
+}
@@ -0,0 +1,25 @@
+package collection
+
+import "math"
+
+// Float includes the built-in floating-point types.
+type Float interface {
+	float32 | float64
+}
+
+// ApproxEqual reports whether a and b differ by at most eps.
+func ApproxEqual[T Float](a, b, eps T) bool {
+	return math.Abs(float64(a)-float64(b)) <= float64(eps)
+}
+
+// ContainsApprox reports whether the slice holds a value within eps of the given value.
+func ContainsApprox[T Float](slice []T, value T, eps T) bool {
+	return Exists(slice, func(item T) bool { return ApproxEqual(item, value, eps) })
+}
+
+// DistinctApprox returns the values of the slice that are not within eps of an earlier kept value,
+// removing duplicates caused by rounding noise. Since closeness is not transitive, the result depends
+// on the order of the slice when values form chains closer than eps.
+func DistinctApprox[T Float](slice []T, eps T) []T {
+	return DistinctFunc(slice, func(a, b T) bool { return ApproxEqual(a, b, eps) })
+}
@@ -0,0 +1,28 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprox(t *testing.T) {
+	tenth, fifth := 0.1, 0.2
+	noisy := []float64{tenth + fifth, 0.3, 1.0, 0.9999999999, 2.5}
+
+	t.Run("Success_distinct_approx", func(t *testing.T) {
+		assert.Len(t, Distinct(noisy), 5)
+		assert.Equal(t, []float64{tenth + fifth, 1.0, 2.5}, DistinctApprox(noisy, 1e-9))
+	})
+
+	t.Run("Success_contains_approx", func(t *testing.T) {
+		assert.True(t, ContainsApprox(noisy, 0.30000001, 1e-6))
+		assert.False(t, ContainsApprox(noisy, 0.31, 1e-6))
+		assert.False(t, ContainsApprox([]float32{}, 1, 1))
+	})
+
+	t.Run("Success_approx_equal", func(t *testing.T) {
+		assert.True(t, ApproxEqual(1.0, 1.05, 0.1))
+		assert.False(t, ApproxEqual(float32(1), 1.5, 0.1))
+	})
+}
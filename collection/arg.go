@@ -0,0 +1,29 @@
+package collection
+
+import "cmp"
+
+// ArgMin returns the index of the element with the smallest selected value, and false for an empty list.
+// On ties the first such element wins.
+func ArgMin[T any, K cmp.Ordered](list []T, selector func(item T) K) (int, bool) {
+	return argExtreme(list, selector, -1)
+}
+
+// ArgMax returns the index of the element with the largest selected value, and false for an empty list.
+// On ties the first such element wins.
+func ArgMax[T any, K cmp.Ordered](list []T, selector func(item T) K) (int, bool) {
+	return argExtreme(list, selector, 1)
+}
+
+// argExtreme finds the first index whose selected value compares to every other with the given sign.
+func argExtreme[T any, K cmp.Ordered](list []T, selector func(item T) K, sign int) (int, bool) {
+	if len(list) == 0 {
+		return -1, false
+	}
+	best, bestKey := 0, selector(list[0])
+	for idx := 1; idx < len(list); idx++ {
+		if key := selector(list[idx]); cmp.Compare(key, bestKey) == sign {
+			best, bestKey = idx, key
+		}
+	}
+	return best, true
+}
@@ -0,0 +1,33 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArgMinArgMax(t *testing.T) {
+	type quote struct {
+		Vendor string
+		Price  float64
+	}
+	quotes := []quote{{"a", 12.5}, {"b", 9.9}, {"c", 15}, {"d", 9.9}, {"e", 15}}
+	price := func(q quote) float64 { return q.Price }
+
+	t.Run("Success_first_on_ties", func(t *testing.T) {
+		cheapest, ok := ArgMin(quotes, price)
+		assert.True(t, ok)
+		assert.Equal(t, 1, cheapest)
+
+		priciest, ok := ArgMax(quotes, price)
+		assert.True(t, ok)
+		assert.Equal(t, 2, priciest)
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		idx, ok := ArgMax([]quote{}, price)
+
+		assert.False(t, ok)
+		assert.Equal(t, -1, idx)
+	})
+}
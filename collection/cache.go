@@ -0,0 +1,114 @@
+package collection
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// Cache is a key/value store consulted by MapWithCache, such as an in-process map or an adapter over
+// Redis or memcached. Implementations used with MapWithCacheParallel must be safe for concurrent use.
+type Cache[K comparable, V any] interface {
+	Get(key K) (V, bool)
+	Set(key K, value V)
+}
+
+// MemoryCache is an unbounded in-memory Cache. It is safe for concurrent use.
+type MemoryCache[K comparable, V any] struct {
+	mu      sync.RWMutex
+	entries map[K]V
+}
+
+// NewMemoryCache creates an empty MemoryCache.
+func NewMemoryCache[K comparable, V any]() *MemoryCache[K, V] {
+	return &MemoryCache[K, V]{entries: make(map[K]V)}
+}
+
+// Get returns the cached value of the key.
+func (c *MemoryCache[K, V]) Get(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	value, exists := c.entries[key]
+	return value, exists
+}
+
+// Set caches the value of the key.
+func (c *MemoryCache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = value
+}
+
+// MapWithCache maps each item through the cache: the key of every item is looked up first and only
+// misses are computed, each distinct key once, with the results stored back into the cache.
+// Results are returned in list order. On failure the error of the first failing item is returned,
+// wrapped with its index; results computed successfully are still cached.
+func MapWithCache[T any, K comparable, R any](source []T, keyFunc func(item T) K, cache Cache[K, R], compute func(item T) (R, error)) ([]R, error) {
+	return MapWithCacheParallel(source, keyFunc, cache, 1, compute)
+}
+
+// MapWithCacheParallel is MapWithCache computing the misses concurrently on the given number of workers.
+// Workers below 1 are treated as 1.
+func MapWithCacheParallel[T any, K comparable, R any](source []T, keyFunc func(item T) K, cache Cache[K, R], workers int, compute func(item T) (R, error)) ([]R, error) {
+	keys := make([]K, len(source))
+	values := make(map[K]R)
+	missIndex := make(map[K]int)
+	misses := []int{}
+	for idx, item := range source {
+		key := keyFunc(item)
+		keys[idx] = key
+		if _, resolved := values[key]; resolved {
+			continue
+		}
+		if _, pending := missIndex[key]; pending {
+			continue
+		}
+		if value, hit := cache.Get(key); hit {
+			values[key] = value
+			continue
+		}
+		missIndex[key] = idx
+		misses = append(misses, idx)
+	}
+
+	computed := make([]R, len(misses))
+	failures := make([]error, len(misses))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < max(min(workers, len(misses)), 1); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				computed[job], failures[job] = compute(source[misses[job]])
+			}
+		}()
+	}
+	for job := range misses {
+		jobs <- job
+	}
+	close(jobs)
+	wg.Wait()
+
+	var firstErr error
+	for job, idx := range misses {
+		if failures[job] != nil {
+			if firstErr == nil {
+				firstErr = errors.Wrap(failures[job], fmt.Sprintf("error computing at index:'%v', error", idx))
+			}
+			continue
+		}
+		cache.Set(keys[idx], computed[job])
+		values[keys[idx]] = computed[job]
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	result := make([]R, len(source))
+	for idx, key := range keys {
+		result[idx] = values[key]
+	}
+	return result, nil
+}
@@ -0,0 +1,69 @@
+package collection
+
+import (
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapWithCache(t *testing.T) {
+	type order struct {
+		ID       string
+		Customer string
+	}
+	orders := []order{{"o1", "alice"}, {"o2", "bob"}, {"o3", "alice"}, {"o4", "carol"}}
+	customer := func(o order) string { return o.Customer }
+
+	t.Run("Success_computes_only_misses_once", func(t *testing.T) {
+		cache := NewMemoryCache[string, string]()
+		cache.Set("bob", "Bob (cached)")
+		var calls int32
+
+		result, err := MapWithCache(orders, customer, Cache[string, string](cache), func(o order) (string, error) {
+			atomic.AddInt32(&calls, 1)
+			return strings.ToUpper(o.Customer), nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ALICE", "Bob (cached)", "ALICE", "CAROL"}, result)
+		assert.Equal(t, int32(2), calls)
+		cached, ok := cache.Get("carol")
+		assert.True(t, ok)
+		assert.Equal(t, "CAROL", cached)
+	})
+
+	t.Run("Success_parallel", func(t *testing.T) {
+		cache := NewMemoryCache[string, int]()
+		var calls int32
+
+		result, err := MapWithCacheParallel(orders, customer, Cache[string, int](cache), 3, func(o order) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			return len(o.Customer), nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{5, 3, 5, 5}, result)
+		assert.Equal(t, int32(3), calls)
+	})
+
+	t.Run("Error_first_failing_item_and_successes_cached", func(t *testing.T) {
+		cache := NewMemoryCache[string, string]()
+
+		result, err := MapWithCacheParallel(orders, customer, Cache[string, string](cache), 2, func(o order) (string, error) {
+			if o.Customer != "alice" {
+				return "", errors.New("lookup failed")
+			}
+			return "Alice", nil
+		})
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "error computing at index:'1', error: lookup failed")
+		_, ok := cache.Get("alice")
+		assert.True(t, ok)
+		_, ok = cache.Get("bob")
+		assert.False(t, ok)
+	})
+}
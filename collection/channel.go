@@ -0,0 +1,120 @@
+package collection
+
+import (
+	"context"
+	"time"
+)
+
+// SliceToChan sends the items of the list on a new channel with the given buffer size from a goroutine.
+// The channel is closed after the last item, or early when the context is cancelled.
+func SliceToChan[T any](ctx context.Context, source []T, buffer int) <-chan T {
+	out := make(chan T, buffer)
+	go func() {
+		defer close(out)
+		for _, item := range source {
+			select {
+			case out <- item:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// ChanToSlice receives items from the channel until it is closed and returns them as a list.
+// If the context is cancelled first, it returns the context error.
+func ChanToSlice[T any](ctx context.Context, in <-chan T) ([]T, error) {
+	result := []T{}
+	for {
+		select {
+		case item, ok := <-in:
+			if !ok {
+				return result, nil
+			}
+			result = append(result, item)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// AggregateWindow buffers items received from in and, once per window, sends the reduction of the
+// buffered items to out; windows without items are skipped. It blocks until in is closed, flushing the
+// remaining items and returning nil, or until the context is cancelled, returning its error.
+// The out channel is not closed.
+func AggregateWindow[T any, R any](ctx context.Context, in <-chan T, window time.Duration, reduce func(items []T) R, out chan<- R) error {
+	ticker := time.NewTicker(window)
+	defer ticker.Stop()
+
+	buffer := []T{}
+	flush := func() error {
+		if len(buffer) == 0 {
+			return nil
+		}
+		result := reduce(buffer)
+		buffer = []T{}
+		select {
+		case out <- result:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	for {
+		select {
+		case item, ok := <-in:
+			if !ok {
+				return flush()
+			}
+			buffer = append(buffer, item)
+		case <-ticker.C:
+			if err := flush(); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// FoldStream folds the items received from in into an accumulator, sending a snapshot of the accumulator
+// on the returned channel after every snapshotEvery items and once more with the final value when in is
+// closed. A snapshotEvery below 1 only sends the final value. The returned channel is closed when in is
+// closed or the context is cancelled.
+func FoldStream[T any, A any](ctx context.Context, in <-chan T, reduceFunc func(acc A, item T) A, initialValue A, snapshotEvery int) <-chan A {
+	out := make(chan A)
+	go func() {
+		defer close(out)
+		acc := initialValue
+		count := 0
+		emit := func() bool {
+			select {
+			case out <- acc:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		for {
+			select {
+			case item, ok := <-in:
+				if !ok {
+					if snapshotEvery < 1 || count%snapshotEvery != 0 || count == 0 {
+						emit()
+					}
+					return
+				}
+				acc = reduceFunc(acc, item)
+				count++
+				if snapshotEvery > 0 && count%snapshotEvery == 0 && !emit() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
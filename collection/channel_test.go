@@ -0,0 +1,148 @@
+package collection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSliceToChan(t *testing.T) {
+	t.Run("Success_round_trip", func(t *testing.T) {
+		ctx := context.Background()
+
+		result, err := ChanToSlice(ctx, SliceToChan(ctx, []int{1, 2, 3}, 0))
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+
+	t.Run("Success_empty_list_closes_channel", func(t *testing.T) {
+		ch := SliceToChan(context.Background(), []string{}, 1)
+
+		_, ok := <-ch
+		assert.False(t, ok)
+	})
+
+	t.Run("Success_cancel_stops_sending", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := SliceToChan(ctx, []int{1, 2, 3, 4}, 0)
+
+		assert.Equal(t, 1, <-ch)
+		cancel()
+
+		for range ch {
+		}
+	})
+}
+
+func TestChanToSlice(t *testing.T) {
+	t.Run("Success_buffered_channel", func(t *testing.T) {
+		ch := make(chan string, 2)
+		ch <- "a"
+		ch <- "b"
+		close(ch)
+
+		result, err := ChanToSlice(context.Background(), ch)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, result)
+	})
+
+	t.Run("Error_context_deadline", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		result, err := ChanToSlice(ctx, make(chan int))
+
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestAggregateWindow(t *testing.T) {
+	t.Run("Success_flush_per_window_and_on_close", func(t *testing.T) {
+		in := make(chan int)
+		out := make(chan int, 10)
+		done := make(chan error)
+
+		go func() {
+			done <- AggregateWindow(context.Background(), in, 30*time.Millisecond, func(items []int) int { return Sum(items) }, out)
+		}()
+
+		in <- 1
+		in <- 2
+		assert.Equal(t, 3, <-out)
+		in <- 5
+		close(in)
+
+		assert.NoError(t, <-done)
+		assert.Equal(t, 5, <-out)
+		assert.Equal(t, 0, len(out))
+	})
+
+	t.Run("Success_empty_windows_skipped", func(t *testing.T) {
+		in := make(chan int)
+		out := make(chan int, 10)
+		done := make(chan error)
+
+		go func() {
+			done <- AggregateWindow(context.Background(), in, 5*time.Millisecond, func(items []int) int { return len(items) }, out)
+		}()
+		time.Sleep(30 * time.Millisecond)
+		close(in)
+
+		assert.NoError(t, <-done)
+		assert.Equal(t, 0, len(out))
+	})
+
+	t.Run("Error_context_cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error)
+
+		go func() {
+			done <- AggregateWindow(ctx, make(chan int), time.Hour, func(items []int) int { return len(items) }, make(chan int))
+		}()
+		cancel()
+
+		assert.ErrorIs(t, <-done, context.Canceled)
+	})
+}
+
+func TestFoldStream(t *testing.T) {
+	sum := func(acc int, item int) int { return acc + item }
+
+	tests := []struct {
+		name          string
+		items         []int
+		snapshotEvery int
+		expected      []int
+	}{
+		{name: "snapshots and final", items: []int{1, 2, 3, 4, 5}, snapshotEvery: 2, expected: []int{3, 10, 15}},
+		{name: "final equals last snapshot", items: []int{1, 2, 3, 4}, snapshotEvery: 2, expected: []int{3, 10}},
+		{name: "final only", items: []int{1, 2, 3}, snapshotEvery: 0, expected: []int{6}},
+		{name: "empty input emits initial", items: []int{}, snapshotEvery: 2, expected: []int{0}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			snapshots, err := ChanToSlice(ctx, FoldStream(ctx, SliceToChan(ctx, tc.items, 0), sum, 0, tc.snapshotEvery))
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, snapshots)
+		})
+	}
+
+	t.Run("Success_cancel_closes_output", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		out := FoldStream(ctx, make(chan int), sum, 0, 1)
+
+		cancel()
+
+		_, ok := <-out
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,35 @@
+package collection
+
+// Chunk splits the list into consecutive chunks of size items; the last chunk may be shorter.
+// Chunks share the backing array of the list but are capacity-limited, so appending to one never
+// overwrites the next. A size below 1 returns nil.
+func Chunk[T any](source []T, size int) [][]T {
+	if size < 1 {
+		return nil
+	}
+	result := make([][]T, 0, len(source)/size+1)
+	for start := 0; start < len(source); {
+		end := start + min(size, len(source)-start)
+		result = append(result, source[start:end:end])
+		start = end
+	}
+	return result
+}
+
+// Window returns the sliding windows of size consecutive items, starting every step items.
+// Only full windows are returned, so a list shorter than size yields none. A step larger than size
+// skips items between windows. Windows share the backing array of the list and are capacity-limited.
+// A size or step below 1 returns nil.
+func Window[T any](source []T, size, step int) [][]T {
+	if size < 1 || step < 1 {
+		return nil
+	}
+	result := [][]T{}
+	for start := 0; size <= len(source)-start; start += step {
+		result = append(result, source[start:start+size:start+size])
+		if step > len(source)-start {
+			break
+		}
+	}
+	return result
+}
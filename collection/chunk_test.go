@@ -0,0 +1,63 @@
+package collection
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   []int
+		size     int
+		expected [][]int
+	}{
+		{"even", []int{1, 2, 3, 4}, 2, [][]int{{1, 2}, {3, 4}}},
+		{"remainder", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}},
+		{"size_larger_than_list", []int{1, 2}, 5, [][]int{{1, 2}}},
+		{"empty", []int{}, 3, [][]int{}},
+		{"invalid_size", []int{1, 2}, 0, nil},
+		{"max_size", []int{1, 2}, math.MaxInt, [][]int{{1, 2}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Chunk(test.source, test.size))
+		})
+	}
+
+	t.Run("Success_append_does_not_overwrite_next_chunk", func(t *testing.T) {
+		source := []int{1, 2, 3, 4}
+		chunks := Chunk(source, 2)
+
+		_ = append(chunks[0], 99)
+
+		assert.Equal(t, []int{1, 2, 3, 4}, source)
+	})
+}
+
+func TestWindow(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   []int
+		size     int
+		step     int
+		expected [][]int
+	}{
+		{"sliding", []int{1, 2, 3, 4, 5}, 3, 1, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}},
+		{"step_two", []int{1, 2, 3, 4, 5}, 2, 2, [][]int{{1, 2}, {3, 4}}},
+		{"step_larger_than_size", []int{1, 2, 3, 4, 5, 6, 7}, 2, 3, [][]int{{1, 2}, {4, 5}}},
+		{"shorter_than_size", []int{1, 2}, 3, 1, [][]int{}},
+		{"invalid_step", []int{1, 2}, 1, 0, nil},
+		{"max_step", []int{1, 2}, 1, math.MaxInt, [][]int{{1}}},
+		{"max_size", []int{1, 2}, math.MaxInt, 1, [][]int{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, Window(test.source, test.size, test.step))
+		})
+	}
+}
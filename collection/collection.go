@@ -1,7 +1,9 @@
 package collection
 
 import (
+	"cmp"
 	"fmt"
+	"reflect"
 	"sort"
 
 	"github.com/pkg/errors"
@@ -25,14 +27,25 @@ import (
 // along with golang-fp-utility. If not, see <https://www.gnu.org/licenses/lgpl-3.0.txt>.
 
 // Map applies a transformation function to each item in the list and returns a new list.
-func Map[T1 any, T2 any](source []T1, transform func(item T1) T2) []T2 {
+// Options such as WithLimit restrict the items that are transformed.
+func Map[T1 any, T2 any](source []T1, transform func(item T1) T2, opts ...SliceOption) []T2 {
+	start, end := ResolveSliceOptions(len(source), opts...)
 	result := []T2{}
-	for _, item := range source {
+	for _, item := range source[start:end] {
 		result = append(result, transform(item))
 	}
 	return result
 }
 
+// MapWithIndex applies a transformation function receiving the position of each item and returns a new list.
+func MapWithIndex[T1 any, T2 any](source []T1, transform func(index int, item T1) T2) []T2 {
+	result := make([]T2, 0, len(source))
+	for idx, item := range source {
+		result = append(result, transform(idx, item))
+	}
+	return result
+}
+
 // FilterMap filters a hashmap based on a provided function.
 func FilterMap[K comparable, V any](source map[K]V, filteringFunc func(key K, value V) bool) map[K]V {
 	result := make(map[K]V)
@@ -53,11 +66,67 @@ func FlatMap[T1 any](source [][]T1) []T1 {
 	return result
 }
 
+// FlatMapFunc expands each item into zero or more results and concatenates them in order.
+func FlatMapFunc[T1 any, T2 any](source []T1, expand func(item T1) []T2) []T2 {
+	result := []T2{}
+	for _, item := range source {
+		result = append(result, expand(item)...)
+	}
+	return result
+}
+
+// FlattenDeep flattens nested slices or arrays of any depth, such as [][][]T, into a single list of T
+// in depth-first order. Nested levels may be held in interfaces, as in []any. An element that is
+// neither a T nor a slice or array is reported as an error. When T is an interface type, slices and
+// arrays are always flattened rather than kept as items, so FlattenDeep[any] yields the leaves.
+func FlattenDeep[T any](source any) ([]T, error) {
+	result := []T{}
+	if err := flattenInto(reflect.ValueOf(source), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func flattenInto[T any](value reflect.Value, result *[]T) error {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	nested := value.Kind() == reflect.Slice || value.Kind() == reflect.Array
+	if nested && reflect.TypeFor[T]().Kind() == reflect.Interface {
+		return flattenElements(value, result)
+	}
+	if value.IsValid() && value.CanInterface() {
+		if item, ok := value.Interface().(T); ok {
+			*result = append(*result, item)
+			return nil
+		}
+	}
+	if !nested {
+		return fmt.Errorf("flattenDeep: cannot flatten %v into %T", value, *new(T))
+	}
+	return flattenElements(value, result)
+}
+
+func flattenElements[T any](value reflect.Value, result *[]T) error {
+	for idx := 0; idx < value.Len(); idx++ {
+		if err := flattenInto(value.Index(idx), result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Reduce reduces a list to a single value using the provided function.
 func Reduce[T any](source []T, reduceFunc func(acc T, item T) T, initialValue T) T {
+	return Fold(source, reduceFunc, initialValue)
+}
+
+// Fold reduces a list to a single value of a possibly different type, e.g. building a map or a string
+// from a list of numbers.
+func Fold[T any, A any](source []T, foldFunc func(acc A, item T) A, initialValue A) A {
 	acc := initialValue
 	for _, item := range source {
-		acc = reduceFunc(acc, item)
+		acc = foldFunc(acc, item)
 	}
 	return acc
 }
@@ -76,6 +145,17 @@ func Sum[T Summable](list []T) T {
 	return total
 }
 
+// Scan folds a list like Fold but returns every intermediate accumulator, one per item.
+func Scan[T any, A any](source []T, foldFunc func(acc A, item T) A, initialValue A) []A {
+	result := make([]A, 0, len(source))
+	acc := initialValue
+	for _, item := range source {
+		acc = foldFunc(acc, item)
+		result = append(result, acc)
+	}
+	return result
+}
+
 // CloneMap creates a shallow copy of the given map.
 func CloneMap[K comparable, V any](source map[K]V) map[K]V {
 	clone := make(map[K]V, len(source))
@@ -92,12 +172,33 @@ func CloneList[T any](source []T) []T {
 	return clone
 }
 
-// Sort sorts a slice using a custom less function.
+// Sort sorts a slice in place using a custom less function and returns it.
+// Use SortCopy or SortBy to leave the input untouched.
 func Sort[T any](list []T, less func(i, j int) bool) []T {
 	sort.Slice(list, less)
 	return list
 }
 
+// SortCopy returns a sorted copy of the list, leaving the input untouched.
+// Elements comparing equal keep their order.
+func SortCopy[T any](list []T, less func(a, b T) bool) []T {
+	sorted := CloneList(list)
+	sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+	return sorted
+}
+
+// SortBy returns a copy of the list sorted in ascending order of the selected key.
+// Elements with equal keys keep their order.
+func SortBy[T any, K cmp.Ordered](list []T, key func(item T) K) []T {
+	return SortCopy(list, func(a, b T) bool { return cmp.Less(key(a), key(b)) })
+}
+
+// SortByDesc returns a copy of the list sorted in descending order of the selected key.
+// Elements with equal keys keep their order.
+func SortByDesc[T any, K cmp.Ordered](list []T, key func(item T) K) []T {
+	return SortCopy(list, func(a, b T) bool { return cmp.Less(key(b), key(a)) })
+}
+
 // Distinct returns a slice containing only unique elements.
 func Distinct[T comparable](slice []T) []T {
 	seen := make(map[T]bool)
@@ -111,13 +212,27 @@ func Distinct[T comparable](slice []T) []T {
 	return unique
 }
 
-// DistinctFunc returns a slice containing unique elements using a custom comparison function.
-func DistinctFunc[T comparable](slice []T, compareFunc func(a, b T) bool) []T {
-	seen := make(map[T]bool)
+// DistinctFunc returns a slice containing unique elements, treating two elements as duplicates when
+// compareFunc reports them equal. The first of the duplicates is kept. It compares every element with
+// the unique elements found so far, so it is quadratic; prefer DistinctBy when a comparable key exists.
+func DistinctFunc[T any](slice []T, compareFunc func(a, b T) bool) []T {
 	unique := []T{}
 	for _, item := range slice {
-		if !seen[item] {
-			seen[item] = true
+		if !Exists(unique, func(kept T) bool { return compareFunc(kept, item) }) {
+			unique = append(unique, item)
+		}
+	}
+	return unique
+}
+
+// DistinctBy returns a slice containing the first element for each key computed by keyFunc.
+func DistinctBy[T any, K comparable](slice []T, keyFunc func(item T) K) []T {
+	seen := make(map[K]bool)
+	unique := []T{}
+	for _, item := range slice {
+		key := keyFunc(item)
+		if !seen[key] {
+			seen[key] = true
 			unique = append(unique, item)
 		}
 	}
@@ -132,21 +247,24 @@ func ForEach[T any](source []T, action func(item T)) {
 }
 
 // ForEachWithError executes a function for each item and handles errors.
+// It stops at the first error, wrapped with the index of the failing item.
 func ForEachWithError[T any](source []T, action func(item T) error) error {
-	for _, item := range source {
+	for idx, item := range source {
 		if err := action(item); err != nil {
-			return err
+			return errors.Wrap(err, fmt.Sprintf("error processing at index:'%v', error", idx))
 		}
 	}
 	return nil
 }
 
 // MapReturnWithError applies a transformation function to each item and handles errors.
-func MapReturnWithError[T1 any, T2 any](source []T1, mappingFunc func(item T1) (T2, error)) ([]T2, error) {
+// Options such as WithLimit restrict the items that are transformed; error indices refer to the full list.
+func MapReturnWithError[T1 any, T2 any](source []T1, mappingFunc func(item T1) (T2, error), opts ...SliceOption) ([]T2, error) {
+	start, end := ResolveSliceOptions(len(source), opts...)
 	result := []T2{}
 
-	for idx, item := range source {
-		res, err := mappingFunc(item)
+	for idx := start; idx < end; idx++ {
+		res, err := mappingFunc(source[idx])
 		if err != nil {
 			return nil, errors.Wrap(err, fmt.Sprintf("error mapping at index:'%v', error", idx))
 		}
@@ -166,6 +284,18 @@ func Filter[T any](source []T, filterFunc func(item T) bool) []T {
 	return result
 }
 
+// FilterWithIndex returns the items accepted by a function receiving the position of each item,
+// e.g. to skip a header row.
+func FilterWithIndex[T any](source []T, filterFunc func(index int, item T) bool) []T {
+	result := []T{}
+	for idx, item := range source {
+		if filterFunc(idx, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
 // Exists checks if any element in the collection satisfies the condition.
 // T is a generic type parameter that can represent any type.
 func Exists[T any](collection []T, condition func(T) bool) bool {
@@ -176,3 +306,27 @@ func Exists[T any](collection []T, condition func(T) bool) bool {
 	}
 	return false
 }
+
+// Find returns the first element satisfying the condition, and false if there is none.
+func Find[T any](source []T, condition func(item T) bool) (T, bool) {
+	if idx := FindIndex(source, condition); idx >= 0 {
+		return source[idx], true
+	}
+	var zero T
+	return zero, false
+}
+
+// FindIndex returns the index of the first element satisfying the condition, or -1 if there is none.
+func FindIndex[T any](source []T, condition func(item T) bool) int {
+	for idx, item := range source {
+		if condition(item) {
+			return idx
+		}
+	}
+	return -1
+}
+
+// CountDistinct returns the number of unique elements in the slice.
+func CountDistinct[T comparable](slice []T) int {
+	return len(Distinct(slice))
+}
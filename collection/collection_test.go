@@ -4,8 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -85,6 +87,30 @@ func TestMap(t *testing.T) {
 	})
 }
 
+func TestMapWithIndex(t *testing.T) {
+	t.Run("Success_row_numbers", func(t *testing.T) {
+		result := MapWithIndex([]string{"alice", "bob"}, func(index int, item string) string {
+			return fmt.Sprintf("%d. %s", index+1, item)
+		})
+
+		assert.Equal(t, []string{"1. alice", "2. bob"}, result)
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		assert.Equal(t, []int{}, MapWithIndex([]int{}, func(index int, item int) int { return index }))
+	})
+}
+
+func TestFilterWithIndex(t *testing.T) {
+	t.Run("Success_skip_header_and_odd_rows", func(t *testing.T) {
+		rows := []string{"name", "alice", "bob", "carol"}
+
+		result := FilterWithIndex(rows, func(index int, row string) bool { return index > 0 && index%2 == 1 })
+
+		assert.Equal(t, []string{"alice", "carol"}, result)
+	})
+}
+
 func TestFilterMap(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -175,6 +201,43 @@ func TestHigherOrderFunction_Reduce(t *testing.T) {
 	})
 }
 
+func TestHigherOrderFunction_Fold(t *testing.T) {
+	t.Run("Success_Ints_To_String", func(t *testing.T) {
+		result := Fold([]int{1, 2, 3}, func(acc string, value int) string {
+			return acc + strconv.Itoa(value)
+		}, ">")
+
+		assert.Equal(t, ">123", result)
+	})
+
+	t.Run("Success_Ints_To_Map", func(t *testing.T) {
+		result := Fold([]int{1, 2, 3, 4, 5}, func(acc map[bool]int, value int) map[bool]int {
+			acc[value%2 == 0] += value
+			return acc
+		}, map[bool]int{})
+
+		assert.Equal(t, map[bool]int{true: 6, false: 9}, result)
+	})
+
+	t.Run("Success_Empty_List", func(t *testing.T) {
+		result := Fold([]int{}, func(acc []string, value int) []string { return append(acc, "x") }, nil)
+
+		assert.Nil(t, result)
+	})
+}
+
+func TestHigherOrderFunction_Scan(t *testing.T) {
+	t.Run("Success_running_lengths", func(t *testing.T) {
+		result := Scan([]string{"a", "bb", "ccc"}, func(acc int, value string) int { return acc + len(value) }, 0)
+
+		assert.Equal(t, []int{1, 3, 6}, result)
+	})
+
+	t.Run("Success_Empty_List", func(t *testing.T) {
+		assert.Equal(t, []int{}, Scan([]int{}, func(acc int, value int) int { return acc + value }, 0))
+	})
+}
+
 func TestHigherOrderFunction_FlatMap(t *testing.T) {
 	t.Run("Success_Int", func(t *testing.T) {
 
@@ -205,6 +268,60 @@ func TestHigherOrderFunction_FlatMap(t *testing.T) {
 	})
 }
 
+func TestFlatMapFunc(t *testing.T) {
+	t.Run("Success_expand_each_item", func(t *testing.T) {
+		result := FlatMapFunc([]string{"a,b", "", "c"}, func(item string) []string {
+			if item == "" {
+				return nil
+			}
+			return strings.Split(item, ",")
+		})
+
+		assert.Equal(t, []string{"a", "b", "c"}, result)
+	})
+}
+
+func TestFlattenDeep(t *testing.T) {
+	t.Run("Success_three_levels", func(t *testing.T) {
+		result, err := FlattenDeep[int]([][][]int{{{1, 2}, {3}}, {}, {{4}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4}, result)
+	})
+
+	t.Run("Success_mixed_depth_in_interfaces", func(t *testing.T) {
+		result, err := FlattenDeep[string]([]any{"a", []string{"b", "c"}, [][]string{{"d"}}, [1]string{"e"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"a", "b", "c", "d", "e"}, result)
+	})
+
+	t.Run("Success_slice_element_type", func(t *testing.T) {
+		result, err := FlattenDeep[[]int]([][][]int{{{1, 2}}, {{3}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]int{{1, 2}, {3}}, result)
+	})
+
+	t.Run("Success_interface_element_type", func(t *testing.T) {
+		result, err := FlattenDeep[any]([]any{1, []any{2, 3}, [][]string{{"a"}}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []any{1, 2, 3, "a"}, result)
+
+		stringers, err := FlattenDeep[fmt.Stringer]([][]fmt.Stringer{{time.Second}, {time.Minute}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []fmt.Stringer{time.Second, time.Minute}, stringers)
+	})
+
+	t.Run("Error_unexpected_leaf", func(t *testing.T) {
+		_, err := FlattenDeep[int]([]any{1, "two"})
+
+		assert.EqualError(t, err, "flattenDeep: cannot flatten two into int")
+	})
+}
+
 func TestSum(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -481,6 +598,22 @@ func TestDistinctFunc(t *testing.T) {
 			},
 			expected: []int{1, 2, 3},
 		},
+		{
+			name:  "case insensitive strings",
+			slice: []string{"Apple", "apple", "Banana", "APPLE", "banana"},
+			fn: func(i, j string) bool {
+				return strings.EqualFold(i, j)
+			},
+			expected: []string{"Apple", "Banana"},
+		},
+		{
+			name:  "same parity",
+			slice: []int{3, 5, 8, 1, 2},
+			fn: func(i, j int) bool {
+				return i%2 == j%2
+			},
+			expected: []int{3, 8},
+		},
 	}
 
 	for _, tc := range tests {
@@ -500,6 +633,32 @@ func TestDistinctFunc(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("non comparable elements", func(t *testing.T) {
+		slice := [][]int{{1, 2}, {3}, {1, 2}, {}}
+
+		unique := DistinctFunc(slice, func(a, b []int) bool { return reflect.DeepEqual(a, b) })
+
+		assert.Equal(t, [][]int{{1, 2}, {3}, {}}, unique)
+	})
+}
+
+func TestDistinctBy(t *testing.T) {
+	type User struct {
+		Email string
+		Name  string
+		Roles []string
+	}
+	users := []User{
+		{Email: "a@x", Name: "Alice", Roles: []string{"admin"}},
+		{Email: "b@x", Name: "Bob"},
+		{Email: "a@x", Name: "Alice (dup)"},
+	}
+
+	unique := DistinctBy(users, func(u User) string { return u.Email })
+
+	assert.Equal(t, []User{users[0], users[1]}, unique)
+	assert.Equal(t, []User{}, DistinctBy([]User{}, func(u User) string { return u.Email }))
 }
 
 func TestFilter(t *testing.T) {
@@ -632,6 +791,7 @@ func TestForEachWithError(t *testing.T) {
 
 		err := ForEachWithError(source, forEachFunc)
 		assert.Error(t, err)
+		assert.Equal(t, "error processing at index:'2', error: error", err.Error())
 	})
 }
 
@@ -677,6 +837,37 @@ func TestCloneStringList(t *testing.T) {
 	}
 }
 
+func TestSortCopyAndSortBy(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+	people := []Person{{"Alice", 30}, {"Bob", 25}, {"Carol", 30}, {"Dave", 20}}
+	original := CloneList(people)
+	age := func(p Person) int { return p.Age }
+
+	t.Run("Success_SortCopy_does_not_mutate", func(t *testing.T) {
+		result := SortCopy(people, func(a, b Person) bool { return a.Name > b.Name })
+
+		assert.Equal(t, []Person{{"Dave", 20}, {"Carol", 30}, {"Bob", 25}, {"Alice", 30}}, result)
+		assert.Equal(t, original, people)
+	})
+
+	t.Run("Success_SortBy_stable", func(t *testing.T) {
+		result := SortBy(people, age)
+
+		assert.Equal(t, []Person{{"Dave", 20}, {"Bob", 25}, {"Alice", 30}, {"Carol", 30}}, result)
+		assert.Equal(t, original, people)
+	})
+
+	t.Run("Success_SortByDesc_stable", func(t *testing.T) {
+		result := SortByDesc(people, age)
+
+		assert.Equal(t, []Person{{"Alice", 30}, {"Carol", 30}, {"Bob", 25}, {"Dave", 20}}, result)
+		assert.Equal(t, original, people)
+	})
+}
+
 func TestMapReturnWithError(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 
@@ -891,3 +1082,33 @@ func TestExists(t *testing.T) {
 		})
 	}
 }
+
+func TestFind(t *testing.T) {
+	testCases := []struct {
+		name          string
+		input         []int
+		expected      int
+		expectedOk    bool
+		expectedIndex int
+	}{
+		{name: "First element greater than 10", input: []int{1, 12, 3, 11}, expected: 12, expectedOk: true, expectedIndex: 1},
+		{name: "No element greater than 10", input: []int{1, 2, 3}, expected: 0, expectedOk: false, expectedIndex: -1},
+		{name: "Empty slice", input: []int{}, expected: 0, expectedOk: false, expectedIndex: -1},
+	}
+	condition := func(n int) bool { return n > 10 }
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, ok := Find(tc.input, condition)
+			assert.Equal(t, tc.expected, result)
+			assert.Equal(t, tc.expectedOk, ok)
+			assert.Equal(t, tc.expectedIndex, FindIndex(tc.input, condition))
+		})
+	}
+}
+
+func TestCountDistinct(t *testing.T) {
+	assert.Equal(t, 3, CountDistinct([]int{1, 2, 2, 3, 1}))
+	assert.Equal(t, 2, CountDistinct([]string{"a", "b", "a"}))
+	assert.Equal(t, 0, CountDistinct([]int{}))
+}
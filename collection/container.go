@@ -0,0 +1,106 @@
+package collection
+
+import (
+	"container/list"
+	"container/ring"
+	"fmt"
+	"sync"
+)
+
+// FromSyncMap copies the entries of a sync.Map into a generic map, so the map helpers can be applied.
+// An entry whose key or value is not of the requested type is reported as an error.
+func FromSyncMap[K comparable, V any](source *sync.Map) (map[K]V, error) {
+	result := make(map[K]V)
+	var err error
+	source.Range(func(rawKey, rawValue any) bool {
+		key, ok := rawKey.(K)
+		if !ok {
+			err = fmt.Errorf("fromSyncMap: key %v is of type %T", rawKey, rawKey)
+			return false
+		}
+		value, ok := rawValue.(V)
+		if !ok && rawValue != nil {
+			err = fmt.Errorf("fromSyncMap: value of key %v is of type %T", rawKey, rawValue)
+			return false
+		}
+		result[key] = value
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ToSyncMap stores the entries of a map in a new sync.Map.
+func ToSyncMap[K comparable, V any](source map[K]V) *sync.Map {
+	result := &sync.Map{}
+	for key, value := range source {
+		result.Store(key, value)
+	}
+	return result
+}
+
+// FromList copies the values of a container/list.List, front to back, into a slice.
+// A value that is not of the requested type is reported as an error.
+func FromList[T any](source *list.List) ([]T, error) {
+	result := make([]T, 0, source.Len())
+	idx := 0
+	for element := source.Front(); element != nil; element = element.Next() {
+		value, ok := element.Value.(T)
+		if !ok && element.Value != nil {
+			return nil, fmt.Errorf("fromList: value at index:'%v' is of type %T", idx, element.Value)
+		}
+		result = append(result, value)
+		idx++
+	}
+	return result, nil
+}
+
+// ToList creates a container/list.List holding the items in order.
+func ToList[T any](source []T) *list.List {
+	result := list.New()
+	for _, item := range source {
+		result.PushBack(item)
+	}
+	return result
+}
+
+// FromRing copies the values of a container/ring.Ring into a slice, starting at the given element.
+// A value that is not of the requested type is reported as an error.
+func FromRing[T any](source *ring.Ring) ([]T, error) {
+	result := make([]T, 0, source.Len())
+	var err error
+	idx := 0
+	source.Do(func(rawValue any) {
+		if err != nil {
+			return
+		}
+		value, ok := rawValue.(T)
+		if !ok && rawValue != nil {
+			err = fmt.Errorf("fromRing: value at index:'%v' is of type %T", idx, rawValue)
+			return
+		}
+		result = append(result, value)
+		idx++
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ToRing creates a container/ring.Ring holding the items in order, returning the element of the first
+// item. An empty list returns nil.
+func ToRing[T any](source []T) *ring.Ring {
+	if len(source) == 0 {
+		return nil
+	}
+	result := ring.New(len(source))
+	current := result
+	for _, item := range source {
+		current.Value = item
+		current = current.Next()
+	}
+	return result
+}
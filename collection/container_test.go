@@ -0,0 +1,79 @@
+package collection
+
+import (
+	"container/list"
+	"container/ring"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSyncMapAdapters(t *testing.T) {
+	t.Run("Success_round_trip_with_filter", func(t *testing.T) {
+		syncMap := ToSyncMap(map[string]int{"a": 1, "b": 2, "c": 3})
+
+		entries, err := FromSyncMap[string, int](syncMap)
+		assert.NoError(t, err)
+		odd := FilterMap(entries, func(key string, value int) bool { return value%2 == 1 })
+
+		assert.Equal(t, map[string]int{"a": 1, "c": 3}, odd)
+	})
+
+	t.Run("Error_wrong_type", func(t *testing.T) {
+		syncMap := &sync.Map{}
+		syncMap.Store("a", "one")
+
+		_, err := FromSyncMap[string, int](syncMap)
+
+		assert.EqualError(t, err, "fromSyncMap: value of key a is of type string")
+	})
+}
+
+func TestListAdapters(t *testing.T) {
+	t.Run("Success_round_trip_with_map", func(t *testing.T) {
+		legacy := ToList([]string{"a", "b"})
+
+		items, err := FromList[string](legacy)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []string{"A", "B"}, Map(items, strings.ToUpper))
+		assert.Equal(t, 2, legacy.Len())
+	})
+
+	t.Run("Error_wrong_type", func(t *testing.T) {
+		legacy := list.New()
+		legacy.PushBack("a")
+		legacy.PushBack(2)
+
+		_, err := FromList[string](legacy)
+
+		assert.EqualError(t, err, "fromList: value at index:'1' is of type int")
+	})
+}
+
+func TestRingAdapters(t *testing.T) {
+	t.Run("Success_round_trip", func(t *testing.T) {
+		buffer := ToRing([]int{1, 2, 3})
+
+		items, err := FromRing[int](buffer)
+		assert.NoError(t, err)
+		rotated, err := FromRing[int](buffer.Next())
+		assert.NoError(t, err)
+
+		assert.Equal(t, []int{1, 2, 3}, items)
+		assert.Equal(t, []int{2, 3, 1}, rotated)
+		assert.Nil(t, ToRing([]int{}))
+	})
+
+	t.Run("Error_wrong_type", func(t *testing.T) {
+		buffer := ring.New(2)
+		buffer.Value = 1
+		buffer.Next().Value = "two"
+
+		_, err := FromRing[int](buffer)
+
+		assert.EqualError(t, err, "fromRing: value at index:'1' is of type string")
+	})
+}
@@ -0,0 +1,41 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// ForEachCtx executes a function for each item, checking the context before every item.
+// It returns ctx.Err() as soon as the context is done, or the first error of the action,
+// wrapped with the index of the failing item.
+func ForEachCtx[T any](ctx context.Context, source []T, action func(ctx context.Context, item T) error) error {
+	for idx, item := range source {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := action(ctx, item); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error processing at index:'%v', error", idx))
+		}
+	}
+	return nil
+}
+
+// MapCtx applies a transformation function to each item, checking the context before every item.
+// It returns ctx.Err() as soon as the context is done, or the first error of the transformation,
+// wrapped with the index of the failing item.
+func MapCtx[T1 any, T2 any](ctx context.Context, source []T1, mappingFunc func(ctx context.Context, item T1) (T2, error)) ([]T2, error) {
+	result := make([]T2, 0, len(source))
+	for idx, item := range source {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		res, err := mappingFunc(ctx, item)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error mapping at index:'%v', error", idx))
+		}
+		result = append(result, res)
+	}
+	return result, nil
+}
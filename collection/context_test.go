@@ -0,0 +1,84 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachCtx(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		total := 0
+
+		err := ForEachCtx(context.Background(), []int{1, 2, 3}, func(ctx context.Context, item int) error {
+			total += item
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 6, total)
+	})
+
+	t.Run("Error_stops_when_cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		processed := []int{}
+
+		err := ForEachCtx(ctx, []int{1, 2, 3, 4}, func(ctx context.Context, item int) error {
+			processed = append(processed, item)
+			if item == 2 {
+				cancel()
+			}
+			return nil
+		})
+
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, []int{1, 2}, processed)
+	})
+
+	t.Run("Error_from_action", func(t *testing.T) {
+		err := ForEachCtx(context.Background(), []int{1, 2}, func(ctx context.Context, item int) error {
+			return errors.New("failed")
+		})
+
+		assert.EqualError(t, err, "error processing at index:'0', error: failed")
+	})
+}
+
+func TestMapCtx(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result, err := MapCtx(context.Background(), []int{1, 2}, func(ctx context.Context, item int) (int, error) {
+			return item * 10, nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{10, 20}, result)
+	})
+
+	t.Run("Error_already_cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		calls := 0
+
+		result, err := MapCtx(ctx, []int{1, 2}, func(ctx context.Context, item int) (int, error) {
+			calls++
+			return item, nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Nil(t, result)
+		assert.Zero(t, calls)
+	})
+
+	t.Run("Error_from_mapping", func(t *testing.T) {
+		_, err := MapCtx(context.Background(), []int{1, 2}, func(ctx context.Context, item int) (int, error) {
+			if item == 2 {
+				return 0, errors.New("failed")
+			}
+			return item, nil
+		})
+
+		assert.EqualError(t, err, "error mapping at index:'1', error: failed")
+	})
+}
@@ -0,0 +1,35 @@
+package collection
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ToStrings converts each item in the list to its string representation.
+// Items implementing fmt.Stringer are rendered through their String method.
+func ToStrings[T any](source []T) []string {
+	return Map(source, func(item T) string { return fmt.Sprint(item) })
+}
+
+// ParseInts parses each string in the list as a base-10 int.
+// The error reports the index of the first item that could not be parsed.
+func ParseInts(source []string) ([]int, error) {
+	return MapReturnWithError(source, strconv.Atoi)
+}
+
+// ParseFloats parses each string in the list as a float64.
+// The error reports the index of the first item that could not be parsed.
+func ParseFloats(source []string) ([]float64, error) {
+	return MapReturnWithError(source, func(item string) (float64, error) {
+		return strconv.ParseFloat(item, 64)
+	})
+}
+
+// ParseTimes parses each string in the list as a time.Time using the given layout.
+// The error reports the index of the first item that could not be parsed.
+func ParseTimes(source []string, layout string) ([]time.Time, error) {
+	return MapReturnWithError(source, func(item string) (time.Time, error) {
+		return time.Parse(layout, item)
+	})
+}
@@ -0,0 +1,88 @@
+package collection
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stringerItem struct {
+	code int
+}
+
+func (s stringerItem) String() string {
+	return "item-" + strconv.Itoa(s.code)
+}
+
+func TestToStrings(t *testing.T) {
+	t.Run("Success_ints", func(t *testing.T) {
+		result := ToStrings([]int{1, 2, 3})
+
+		assert.Equal(t, []string{"1", "2", "3"}, result)
+	})
+
+	t.Run("Success_stringer", func(t *testing.T) {
+		result := ToStrings([]stringerItem{{code: 1}, {code: 2}})
+
+		assert.Equal(t, []string{"item-1", "item-2"}, result)
+	})
+
+	t.Run("Success_empty_list", func(t *testing.T) {
+		result := ToStrings([]float64{})
+
+		assert.Equal(t, []string{}, result)
+	})
+}
+
+func TestParseInts(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result, err := ParseInts([]string{"1", "-2", "30"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, -2, 30}, result)
+	})
+
+	t.Run("Error_reports_index", func(t *testing.T) {
+		result, err := ParseInts([]string{"1", "2", "x"})
+
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error mapping at index:'2'")
+	})
+}
+
+func TestParseFloats(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result, err := ParseFloats([]string{"1.5", "2", "-0.25"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{1.5, 2, -0.25}, result)
+	})
+
+	t.Run("Error_reports_index", func(t *testing.T) {
+		result, err := ParseFloats([]string{"abc"})
+
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error mapping at index:'0'")
+	})
+}
+
+func TestParseTimes(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result, err := ParseTimes([]string{"2024-01-02", "2024-12-31"}, time.DateOnly)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []time.Time{
+			time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+		}, result)
+	})
+
+	t.Run("Error_reports_index", func(t *testing.T) {
+		result, err := ParseTimes([]string{"2024-01-02", "02/01/2024"}, time.DateOnly)
+
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error mapping at index:'1'")
+	})
+}
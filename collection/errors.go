@@ -0,0 +1,124 @@
+package collection
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// MapErrorSlice applies a transformation function to each non-nil error in the list,
+// e.g. to wrap or classify errors collected from a batch. Nil entries stay nil.
+func MapErrorSlice(source []error, transform func(err error) error) []error {
+	return Map(source, func(err error) error {
+		if err == nil {
+			return nil
+		}
+		return transform(err)
+	})
+}
+
+// ItemError is the failure of a single item of a batch, identified by its index or key.
+type ItemError struct {
+	Index int
+	Key   any
+	Err   error
+}
+
+// Error renders the failure with its index, or its key when one is set.
+func (e ItemError) Error() string {
+	if e.Key != nil {
+		return fmt.Sprintf("error at key:'%v', error: %v", e.Key, e.Err)
+	}
+	return fmt.Sprintf("error at index:'%v', error: %v", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e ItemError) Unwrap() error {
+	return e.Err
+}
+
+// MarshalJSON renders the failure as {"index", "key", "error"}. A nil Err renders as an empty message.
+func (e ItemError) MarshalJSON() ([]byte, error) {
+	message := ""
+	if e.Err != nil {
+		message = e.Err.Error()
+	}
+	return json.Marshal(struct {
+		Index int    `json:"index"`
+		Key   any    `json:"key,omitempty"`
+		Error string `json:"error"`
+	}{Index: e.Index, Key: e.Key, Error: message})
+}
+
+// BatchError aggregates the per-item failures of a batch operation run in collect-errors mode.
+// It works with errors.Is and errors.As against any of the item errors.
+type BatchError struct {
+	items []ItemError
+}
+
+// NewBatchError creates a *BatchError from item failures, or returns a nil error when there are none.
+func NewBatchError(items []ItemError) error {
+	if len(items) == 0 {
+		return nil
+	}
+	return &BatchError{items: CloneList(items)}
+}
+
+// Items returns the per-item failures in the order they were recorded.
+func (e *BatchError) Items() []ItemError {
+	return CloneList(e.items)
+}
+
+// Error renders the number of failures followed by each failure.
+func (e *BatchError) Error() string {
+	messages := ToStrings(e.items)
+	return fmt.Sprintf("%d item(s) failed: %s", len(e.items), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the item errors, so errors.Is and errors.As inspect each of them.
+func (e *BatchError) Unwrap() []error {
+	return Map(e.items, func(item ItemError) error { return item })
+}
+
+// MarshalJSON renders the batch as {"failed", "items"} for structured API failure reports.
+func (e *BatchError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Failed int         `json:"failed"`
+		Items  []ItemError `json:"items"`
+	}{Failed: len(e.items), Items: e.items})
+}
+
+// MapCollectErrors applies a transformation function to every item, unlike MapReturnWithError
+// it does not stop at the first failure. If any item fails it returns a *BatchError listing every
+// failing index.
+func MapCollectErrors[T1 any, T2 any](source []T1, mappingFunc func(item T1) (T2, error)) ([]T2, error) {
+	result := []T2{}
+	failures := []ItemError{}
+	for idx, item := range source {
+		res, err := mappingFunc(item)
+		if err != nil {
+			failures = append(failures, ItemError{Index: idx, Err: err})
+			continue
+		}
+		result = append(result, res)
+	}
+	if len(failures) > 0 {
+		return nil, NewBatchError(failures)
+	}
+	return result, nil
+}
+
+// ForEachCollectErrors executes a function for every item, unlike ForEachWithError it does not stop at
+// the first failure. If any item fails it returns a *BatchError listing every failing index.
+func ForEachCollectErrors[T any](source []T, action func(item T) error) error {
+	failures := []ItemError{}
+	for idx, item := range source {
+		if err := action(item); err != nil {
+			failures = append(failures, ItemError{Index: idx, Err: err})
+		}
+	}
+	if len(failures) > 0 {
+		return NewBatchError(failures)
+	}
+	return nil
+}
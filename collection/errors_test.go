@@ -0,0 +1,133 @@
+package collection
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapErrorSlice(t *testing.T) {
+	t.Run("Success_wraps_non_nil_errors", func(t *testing.T) {
+		errTimeout := errors.New("timeout")
+		source := []error{errTimeout, nil, errors.New("refused")}
+
+		result := MapErrorSlice(source, func(err error) error { return fmt.Errorf("enrich: %w", err) })
+
+		assert.Len(t, result, 3)
+		assert.EqualError(t, result[0], "enrich: timeout")
+		assert.ErrorIs(t, result[0], errTimeout)
+		assert.Nil(t, result[1])
+		assert.EqualError(t, result[2], "enrich: refused")
+	})
+
+	t.Run("Success_empty_list", func(t *testing.T) {
+		assert.Equal(t, []error{}, MapErrorSlice(nil, func(err error) error { return err }))
+	})
+}
+
+func TestBatchError(t *testing.T) {
+	errNotFound := errors.New("not found")
+	items := []ItemError{
+		{Index: 1, Err: errNotFound},
+		{Index: 3, Key: "sku-9", Err: errors.New("invalid price")},
+	}
+
+	t.Run("Error_message", func(t *testing.T) {
+		err := NewBatchError(items)
+
+		assert.EqualError(t, err, "2 item(s) failed: error at index:'1', error: not found; error at key:'sku-9', error: invalid price")
+	})
+
+	t.Run("Items_are_copied", func(t *testing.T) {
+		var err *BatchError
+		assert.True(t, errors.As(NewBatchError(items), &err))
+
+		returned := err.Items()
+		returned[0].Index = 100
+
+		assert.Equal(t, items, err.Items())
+	})
+
+	t.Run("Errors_is_and_as", func(t *testing.T) {
+		var err error = NewBatchError(items)
+
+		assert.ErrorIs(t, err, errNotFound)
+
+		var itemErr ItemError
+		assert.True(t, errors.As(err, &itemErr))
+		assert.Equal(t, 1, itemErr.Index)
+	})
+
+	t.Run("MarshalJSON", func(t *testing.T) {
+		data, err := json.Marshal(NewBatchError(items))
+		assert.NoError(t, err)
+
+		expected := `{"failed":2,"items":[{"index":1,"error":"not found"},{"index":3,"key":"sku-9","error":"invalid price"}]}`
+		assert.JSONEq(t, expected, string(data))
+	})
+
+	t.Run("Nil_without_failures", func(t *testing.T) {
+		var err error = NewBatchError(nil)
+
+		assert.NoError(t, err)
+		assert.True(t, err == nil)
+	})
+
+	t.Run("MarshalJSON_nil_item_error", func(t *testing.T) {
+		data, err := json.Marshal(ItemError{Index: 1})
+		assert.NoError(t, err)
+
+		assert.JSONEq(t, `{"index":1,"error":""}`, string(data))
+	})
+}
+
+func TestMapCollectErrors(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result, err := MapCollectErrors([]string{"1", "2"}, strconv.Atoi)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, result)
+	})
+
+	t.Run("Error_collects_every_failure", func(t *testing.T) {
+		result, err := MapCollectErrors([]string{"x", "2", "y"}, strconv.Atoi)
+
+		assert.Nil(t, result)
+		var batchErr *BatchError
+		assert.True(t, errors.As(err, &batchErr))
+		assert.Equal(t, []int{0, 2}, Map(batchErr.Items(), func(item ItemError) int { return item.Index }))
+	})
+}
+
+func TestForEachCollectErrors(t *testing.T) {
+	t.Run("Success_runs_every_item", func(t *testing.T) {
+		visited := []int{}
+
+		err := ForEachCollectErrors([]int{1, 2, 3}, func(item int) error {
+			visited = append(visited, item)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3}, visited)
+	})
+
+	t.Run("Error_joined_with_indices", func(t *testing.T) {
+		visited := []int{}
+
+		err := ForEachCollectErrors([]int{1, 2, 3, 4}, func(item int) error {
+			visited = append(visited, item)
+			if item%2 == 0 {
+				return fmt.Errorf("even item %d", item)
+			}
+			return nil
+		})
+
+		assert.Equal(t, []int{1, 2, 3, 4}, visited)
+		assert.EqualError(t, err, "2 item(s) failed: error at index:'1', error: even item 2; error at index:'3', error: even item 4")
+	})
+}
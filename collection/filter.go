@@ -0,0 +1,51 @@
+package collection
+
+import (
+	"fmt"
+	"reflect"
+
+	reflection "github.com/lumiluminousai/golang-fp-utility/reflection"
+)
+
+// FilterEqual returns the items whose selected value equals the given value.
+func FilterEqual[T any, K comparable](source []T, selector func(item T) K, value K) []T {
+	return Filter(source, func(item T) bool { return selector(item) == value })
+}
+
+// FilterIn returns the items whose selected value is present in the allowed set.
+// The set has the shape produced by SliceToHashMap.
+func FilterIn[T any, K comparable](source []T, selector func(item T) K, allowed map[K]bool) []T {
+	return Filter(source, func(item T) bool { return allowed[selector(item)] })
+}
+
+// Partition splits the list in one traversal into the items satisfying the predicate and the rest,
+// both keeping their order.
+func Partition[T any](source []T, predicate func(item T) bool) (matching []T, rest []T) {
+	rest, matching = RemoveWhere(source, predicate)
+	return matching, rest
+}
+
+// FilterEqualByField returns the items whose field, addressed by a dot-separated path, equals the given value.
+func FilterEqualByField[T any, K comparable](source []T, fieldName string, value K) ([]T, error) {
+	result := []T{}
+	for _, item := range source {
+		fieldValue := reflection.GetField(reflect.ValueOf(item), fieldName)
+		if !fieldValue.IsValid() {
+			return nil, fmt.Errorf("filterEqual: field %s does not exist", fieldName)
+		}
+		if !fieldValue.CanInterface() {
+			return nil, fmt.Errorf("filterEqual: field %s is not exported", fieldName)
+		}
+		fieldKey, ok := fieldValue.Interface().(K)
+		if !ok {
+			return nil, fmt.Errorf("filterEqual: field %s is of type %s", fieldName, fieldValue.Type())
+		}
+		if dynamic := reflect.ValueOf(fieldKey); dynamic.IsValid() && !dynamic.Comparable() {
+			return nil, fmt.Errorf("filterEqual: field %s holds non-comparable type %s", fieldName, dynamic.Type())
+		}
+		if fieldKey == value {
+			result = append(result, item)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,111 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type filterOrder struct {
+	Code     string
+	Status   string
+	Customer filterCustomer
+}
+
+type filterCustomer struct {
+	Country string
+}
+
+var filterOrders = []filterOrder{
+	{Code: "O1", Status: "open", Customer: filterCustomer{Country: "TH"}},
+	{Code: "O2", Status: "closed", Customer: filterCustomer{Country: "JP"}},
+	{Code: "O3", Status: "open", Customer: filterCustomer{Country: "JP"}},
+	{Code: "O4", Status: "cancelled", Customer: filterCustomer{Country: "US"}},
+}
+
+func TestFilterEqual(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result := FilterEqual(filterOrders, func(o filterOrder) string { return o.Status }, "open")
+
+		assert.Equal(t, []filterOrder{filterOrders[0], filterOrders[2]}, result)
+	})
+
+	t.Run("Success_no_match", func(t *testing.T) {
+		result := FilterEqual(filterOrders, func(o filterOrder) string { return o.Status }, "pending")
+
+		assert.Equal(t, []filterOrder{}, result)
+	})
+}
+
+func TestFilterIn(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		allowed := map[string]bool{"closed": true, "cancelled": true}
+
+		result := FilterIn(filterOrders, func(o filterOrder) string { return o.Status }, allowed)
+
+		assert.Equal(t, []filterOrder{filterOrders[1], filterOrders[3]}, result)
+	})
+
+	t.Run("Success_empty_set", func(t *testing.T) {
+		result := FilterIn(filterOrders, func(o filterOrder) string { return o.Status }, map[string]bool{})
+
+		assert.Equal(t, []filterOrder{}, result)
+	})
+}
+
+func TestPartition(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		open, rest := Partition(filterOrders, func(o filterOrder) bool { return o.Status == "open" })
+
+		assert.Equal(t, []filterOrder{filterOrders[0], filterOrders[2]}, open)
+		assert.Equal(t, []filterOrder{filterOrders[1], filterOrders[3]}, rest)
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		matching, rest := Partition([]int{}, func(n int) bool { return n > 0 })
+
+		assert.Equal(t, []int{}, matching)
+		assert.Equal(t, []int{}, rest)
+	})
+}
+
+func TestFilterEqualByField(t *testing.T) {
+	t.Run("Success_layer1_field", func(t *testing.T) {
+		result, err := FilterEqualByField(filterOrders, "Status", "open")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []filterOrder{filterOrders[0], filterOrders[2]}, result)
+	})
+
+	t.Run("Success_layer2_field", func(t *testing.T) {
+		result, err := FilterEqualByField(filterOrders, "Customer.Country", "JP")
+		assert.NoError(t, err)
+
+		assert.Equal(t, []filterOrder{filterOrders[1], filterOrders[2]}, result)
+	})
+
+	t.Run("Error_invalid_field_name", func(t *testing.T) {
+		result, err := FilterEqualByField(filterOrders, "Nonexistent", "open")
+
+		assert.Nil(t, result)
+		assert.Equal(t, "filterEqual: field Nonexistent does not exist", err.Error())
+	})
+
+	t.Run("Error_field_type_mismatch", func(t *testing.T) {
+		result, err := FilterEqualByField(filterOrders, "Status", 1)
+
+		assert.Nil(t, result)
+		assert.Equal(t, "filterEqual: field Status is of type string", err.Error())
+	})
+
+	t.Run("Error_non_comparable_field", func(t *testing.T) {
+		type tagged struct {
+			Tags []string
+		}
+
+		result, err := FilterEqualByField[tagged, any]([]tagged{{Tags: []string{"a"}}}, "Tags", []string{"a"})
+
+		assert.Nil(t, result)
+		assert.Equal(t, "filterEqual: field Tags holds non-comparable type []string", err.Error())
+	})
+}
@@ -0,0 +1,60 @@
+package collection
+
+import "sync"
+
+// LazySlice is a transformed view of a list whose elements are computed on first access and cached.
+// It is safe for concurrent use.
+type LazySlice[T any] struct {
+	length  int
+	compute func(index int) T
+	values  []T
+	once    []sync.Once
+}
+
+// LazyMap returns a LazySlice applying the transformation function to each item only when it is accessed.
+// The source list is copied, so later changes to it do not affect the result.
+func LazyMap[T1 any, T2 any](source []T1, transform func(item T1) T2) *LazySlice[T2] {
+	items := CloneList(source)
+	return &LazySlice[T2]{
+		length:  len(items),
+		compute: func(index int) T2 { return transform(items[index]) },
+		values:  make([]T2, len(items)),
+		once:    make([]sync.Once, len(items)),
+	}
+}
+
+// Len returns the number of elements in the slice.
+func (s *LazySlice[T]) Len() int {
+	return s.length
+}
+
+// At returns the element at the given index, computing it on first access, and whether it exists.
+// Negative indices count from the end, as with At on lists.
+func (s *LazySlice[T]) At(index int) (T, bool) {
+	if index < 0 {
+		index += s.length
+	}
+	if index < 0 || index >= s.length {
+		var zero T
+		return zero, false
+	}
+	s.once[index].Do(func() { s.values[index] = s.compute(index) })
+	return s.values[index], true
+}
+
+// Slice returns the elements in [from, to), computing only those, with bounds clamped like SafeSlice.
+func (s *LazySlice[T]) Slice(from, to int) []T {
+	from = clampIndex(from, s.length)
+	to = clampIndex(to, s.length)
+	result := []T{}
+	for i := from; i < to; i++ {
+		value, _ := s.At(i)
+		result = append(result, value)
+	}
+	return result
+}
+
+// ToSlice computes every remaining element and returns them as a new list.
+func (s *LazySlice[T]) ToSlice() []T {
+	return s.Slice(0, s.length)
+}
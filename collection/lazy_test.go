@@ -0,0 +1,72 @@
+package collection
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLazyMap(t *testing.T) {
+	t.Run("Success_computes_on_access_only", func(t *testing.T) {
+		var calls int32
+		lazy := LazyMap([]int{1, 2, 3, 4, 5}, func(item int) int {
+			atomic.AddInt32(&calls, 1)
+			return item * 10
+		})
+
+		assert.Equal(t, 5, lazy.Len())
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+
+		value, ok := lazy.At(1)
+		assert.True(t, ok)
+		assert.Equal(t, 20, value)
+
+		value, ok = lazy.At(1)
+		assert.True(t, ok)
+		assert.Equal(t, 20, value)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+		assert.Equal(t, []int{10, 20}, lazy.Slice(0, 2))
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+
+		assert.Equal(t, []int{10, 20, 30, 40, 50}, lazy.ToSlice())
+		assert.Equal(t, int32(5), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("Success_bounds", func(t *testing.T) {
+		lazy := LazyMap([]string{"a", "b"}, func(item string) string { return item + item })
+
+		value, ok := lazy.At(-1)
+		assert.True(t, ok)
+		assert.Equal(t, "bb", value)
+
+		_, ok = lazy.At(2)
+		assert.False(t, ok)
+
+		assert.Equal(t, []string{"bb"}, lazy.Slice(1, 10))
+		assert.Equal(t, []string{}, lazy.Slice(5, 10))
+	})
+
+	t.Run("Success_concurrent_access_computes_once", func(t *testing.T) {
+		var calls int32
+		lazy := LazyMap([]int{7}, func(item int) int {
+			atomic.AddInt32(&calls, 1)
+			return item
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 16; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				value, _ := lazy.At(0)
+				assert.Equal(t, 7, value)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+}
@@ -0,0 +1,37 @@
+package collection
+
+// UpdateWhere applies the update function in place to every item matching the predicate.
+// Like Sort, it mutates and returns the given list.
+func UpdateWhere[T any](list []T, predicate func(item T) bool, update func(item *T)) []T {
+	for i := range list {
+		if predicate(list[i]) {
+			update(&list[i])
+		}
+	}
+	return list
+}
+
+// ReplaceWhere returns a new list in which every item matching the predicate is replaced by newValue.
+func ReplaceWhere[T any](source []T, predicate func(item T) bool, newValue T) []T {
+	return Map(source, func(item T) T {
+		if predicate(item) {
+			return newValue
+		}
+		return item
+	})
+}
+
+// RemoveWhere splits the list in one traversal into the items that are kept
+// and the items removed because they match the predicate.
+func RemoveWhere[T any](source []T, predicate func(item T) bool) (kept []T, removed []T) {
+	kept = []T{}
+	removed = []T{}
+	for _, item := range source {
+		if predicate(item) {
+			removed = append(removed, item)
+		} else {
+			kept = append(kept, item)
+		}
+	}
+	return kept, removed
+}
@@ -0,0 +1,71 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type modifyItem struct {
+	Name  string
+	Price int
+}
+
+func TestUpdateWhere(t *testing.T) {
+	t.Run("Success_updates_in_place", func(t *testing.T) {
+		source := []modifyItem{{"a", 10}, {"b", 20}, {"c", 30}}
+
+		result := UpdateWhere(source, func(item modifyItem) bool { return item.Price >= 20 }, func(item *modifyItem) {
+			item.Price *= 2
+		})
+
+		expected := []modifyItem{{"a", 10}, {"b", 40}, {"c", 60}}
+		assert.Equal(t, expected, result)
+		assert.Equal(t, expected, source)
+	})
+
+	t.Run("Success_no_match", func(t *testing.T) {
+		source := []modifyItem{{"a", 10}}
+
+		result := UpdateWhere(source, func(item modifyItem) bool { return false }, func(item *modifyItem) {
+			item.Name = "changed"
+		})
+
+		assert.Equal(t, []modifyItem{{"a", 10}}, result)
+	})
+}
+
+func TestReplaceWhere(t *testing.T) {
+	t.Run("Success_returns_copy", func(t *testing.T) {
+		source := []int{1, -2, 3, -4}
+
+		result := ReplaceWhere(source, func(item int) bool { return item < 0 }, 0)
+
+		assert.Equal(t, []int{1, 0, 3, 0}, result)
+		assert.Equal(t, []int{1, -2, 3, -4}, source)
+	})
+
+	t.Run("Success_empty_list", func(t *testing.T) {
+		result := ReplaceWhere([]int{}, func(item int) bool { return true }, 0)
+
+		assert.Equal(t, []int{}, result)
+	})
+}
+
+func TestRemoveWhere(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		source := []modifyItem{{"a", 10}, {"b", 0}, {"c", 30}, {"d", 0}}
+
+		kept, removed := RemoveWhere(source, func(item modifyItem) bool { return item.Price == 0 })
+
+		assert.Equal(t, []modifyItem{{"a", 10}, {"c", 30}}, kept)
+		assert.Equal(t, []modifyItem{{"b", 0}, {"d", 0}}, removed)
+	})
+
+	t.Run("Success_nil_list", func(t *testing.T) {
+		kept, removed := RemoveWhere([]int(nil), func(item int) bool { return true })
+
+		assert.Equal(t, []int{}, kept)
+		assert.Equal(t, []int{}, removed)
+	})
+}
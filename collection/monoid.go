@@ -0,0 +1,63 @@
+package collection
+
+import "cmp"
+
+// Monoid combines values of a type with an associative operation and an identity element.
+// Because Combine is associative and Empty is its identity, folds can be split into
+// chunks or streamed and still produce the same result.
+type Monoid[T any] interface {
+	Empty() T
+	Combine(a, b T) T
+}
+
+type funcMonoid[T any] struct {
+	empty   func() T
+	combine func(a, b T) T
+}
+
+func (m funcMonoid[T]) Empty() T { return m.empty() }
+
+func (m funcMonoid[T]) Combine(a, b T) T { return m.combine(a, b) }
+
+// NewMonoid creates a Monoid from an identity constructor and an associative combine function.
+func NewMonoid[T any](empty func() T, combine func(a, b T) T) Monoid[T] {
+	return funcMonoid[T]{empty: empty, combine: combine}
+}
+
+// SumMonoid combines numbers by addition, with 0 as identity.
+func SumMonoid[T Summable]() Monoid[T] {
+	return NewMonoid(func() T { return 0 }, func(a, b T) T { return a + b })
+}
+
+// ProductMonoid combines numbers by multiplication, with 1 as identity.
+func ProductMonoid[T Summable]() Monoid[T] {
+	return NewMonoid(func() T { return 1 }, func(a, b T) T { return a * b })
+}
+
+// MinMonoid keeps the smaller value. The identity must be an upper bound of the values, e.g. math.MaxInt.
+func MinMonoid[T cmp.Ordered](identity T) Monoid[T] {
+	return NewMonoid(func() T { return identity }, func(a, b T) T { return min(a, b) })
+}
+
+// MaxMonoid keeps the larger value. The identity must be a lower bound of the values, e.g. math.MinInt.
+func MaxMonoid[T cmp.Ordered](identity T) Monoid[T] {
+	return NewMonoid(func() T { return identity }, func(a, b T) T { return max(a, b) })
+}
+
+// StringConcatMonoid concatenates strings, with "" as identity.
+func StringConcatMonoid() Monoid[string] {
+	return NewMonoid(func() string { return "" }, func(a, b string) string { return a + b })
+}
+
+// AppendMonoid concatenates slices into a new slice, with an empty slice as identity.
+func AppendMonoid[T any]() Monoid[[]T] {
+	return NewMonoid(func() []T { return []T{} }, func(a, b []T) []T {
+		result := make([]T, 0, len(a)+len(b))
+		return append(append(result, a...), b...)
+	})
+}
+
+// FoldMonoid reduces a list to a single value with the monoid, returning its identity for an empty list.
+func FoldMonoid[T any](source []T, monoid Monoid[T]) T {
+	return Reduce(source, monoid.Combine, monoid.Empty())
+}
@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFoldMonoid(t *testing.T) {
+	t.Run("Sum", func(t *testing.T) {
+		assert.Equal(t, 10, FoldMonoid([]int{1, 2, 3, 4}, SumMonoid[int]()))
+		assert.Equal(t, 0, FoldMonoid([]int{}, SumMonoid[int]()))
+	})
+
+	t.Run("Product", func(t *testing.T) {
+		assert.Equal(t, 24.0, FoldMonoid([]float64{1, 2, 3, 4}, ProductMonoid[float64]()))
+		assert.Equal(t, 1, FoldMonoid([]int{}, ProductMonoid[int]()))
+	})
+
+	t.Run("Min_and_Max", func(t *testing.T) {
+		source := []int{4, -2, 9, 3}
+
+		assert.Equal(t, -2, FoldMonoid(source, MinMonoid(math.MaxInt)))
+		assert.Equal(t, 9, FoldMonoid(source, MaxMonoid(math.MinInt)))
+		assert.Equal(t, math.MaxInt, FoldMonoid([]int{}, MinMonoid(math.MaxInt)))
+	})
+
+	t.Run("String_concat", func(t *testing.T) {
+		assert.Equal(t, "abc", FoldMonoid([]string{"a", "b", "c"}, StringConcatMonoid()))
+	})
+
+	t.Run("Append", func(t *testing.T) {
+		source := [][]int{{1, 2}, {}, {3}}
+
+		assert.Equal(t, []int{1, 2, 3}, FoldMonoid(source, AppendMonoid[int]()))
+		assert.Equal(t, []int{}, FoldMonoid([][]int{}, AppendMonoid[int]()))
+	})
+
+	t.Run("Custom_monoid", func(t *testing.T) {
+		type stats struct {
+			Count int
+			Total int
+		}
+		monoid := NewMonoid(func() stats { return stats{} }, func(a, b stats) stats {
+			return stats{Count: a.Count + b.Count, Total: a.Total + b.Total}
+		})
+		source := Map([]int{3, 5, 7}, func(item int) stats { return stats{Count: 1, Total: item} })
+
+		assert.Equal(t, stats{Count: 3, Total: 15}, FoldMonoid(source, monoid))
+	})
+
+	t.Run("Chunked_fold_matches_whole_fold", func(t *testing.T) {
+		source := []string{"a", "b", "c", "d", "e"}
+		monoid := StringConcatMonoid()
+
+		left := FoldMonoid(source[:2], monoid)
+		right := FoldMonoid(source[2:], monoid)
+
+		assert.Equal(t, FoldMonoid(source, monoid), monoid.Combine(left, right))
+	})
+}
@@ -0,0 +1,45 @@
+package collection
+
+// The functions in this file mirror their counterparts but return nil for nil inputs
+// instead of normalizing them to empty collections, so nil-versus-empty round-trips
+// (e.g. JSON omitempty or null) are preserved.
+
+// CloneListPreserveNil creates a shallow copy of the given list, returning nil for a nil list.
+func CloneListPreserveNil[T any](source []T) []T {
+	if source == nil {
+		return nil
+	}
+	return CloneList(source)
+}
+
+// CloneMapPreserveNil creates a shallow copy of the given map, returning nil for a nil map.
+func CloneMapPreserveNil[K comparable, V any](source map[K]V) map[K]V {
+	if source == nil {
+		return nil
+	}
+	return CloneMap(source)
+}
+
+// MapPreserveNil applies a transformation function to each item in the list, returning nil for a nil list.
+func MapPreserveNil[T1 any, T2 any](source []T1, transform func(item T1) T2) []T2 {
+	if source == nil {
+		return nil
+	}
+	return Map(source, transform)
+}
+
+// FilterPreserveNil returns a filtered list based on the provided function, returning nil for a nil list.
+func FilterPreserveNil[T any](source []T, filterFunc func(item T) bool) []T {
+	if source == nil {
+		return nil
+	}
+	return Filter(source, filterFunc)
+}
+
+// FilterMapPreserveNil filters a hashmap based on a provided function, returning nil for a nil map.
+func FilterMapPreserveNil[K comparable, V any](source map[K]V, filteringFunc func(key K, value V) bool) map[K]V {
+	if source == nil {
+		return nil
+	}
+	return FilterMap(source, filteringFunc)
+}
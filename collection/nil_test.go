@@ -0,0 +1,46 @@
+package collection
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPreserveNil(t *testing.T) {
+	double := func(item int) int { return item * 2 }
+	isEven := func(item int) bool { return item%2 == 0 }
+	keepAll := func(key string, value int) bool { return true }
+
+	t.Run("Nil_inputs_stay_nil", func(t *testing.T) {
+		assert.Nil(t, CloneListPreserveNil([]int(nil)))
+		assert.Nil(t, CloneMapPreserveNil(map[string]int(nil)))
+		assert.Nil(t, MapPreserveNil([]int(nil), double))
+		assert.Nil(t, FilterPreserveNil([]int(nil), isEven))
+		assert.Nil(t, FilterMapPreserveNil(map[string]int(nil), keepAll))
+	})
+
+	t.Run("Empty_inputs_stay_empty", func(t *testing.T) {
+		assert.Equal(t, []int{}, CloneListPreserveNil([]int{}))
+		assert.Equal(t, map[string]int{}, CloneMapPreserveNil(map[string]int{}))
+		assert.Equal(t, []int{}, MapPreserveNil([]int{}, double))
+		assert.Equal(t, []int{}, FilterPreserveNil([]int{}, isEven))
+		assert.Equal(t, map[string]int{}, FilterMapPreserveNil(map[string]int{}, keepAll))
+	})
+
+	t.Run("Non_empty_inputs", func(t *testing.T) {
+		assert.Equal(t, []int{2, 4}, MapPreserveNil([]int{1, 2}, double))
+		assert.Equal(t, []int{2}, FilterPreserveNil([]int{1, 2, 3}, isEven))
+		assert.Equal(t, map[string]int{"a": 1}, CloneMapPreserveNil(map[string]int{"a": 1}))
+	})
+
+	t.Run("JSON_round_trip", func(t *testing.T) {
+		nilJSON, err := json.Marshal(MapPreserveNil([]int(nil), double))
+		assert.NoError(t, err)
+		assert.Equal(t, "null", string(nilJSON))
+
+		emptyJSON, err := json.Marshal(MapPreserveNil([]int{}, double))
+		assert.NoError(t, err)
+		assert.Equal(t, "[]", string(emptyJSON))
+	})
+}
@@ -0,0 +1,23 @@
+package collection
+
+// FilterNoAlloc appends the items matching the filter function to dst[:0] and returns it.
+// Reusing dst across calls avoids allocating once its capacity suffices; dst must not overlap source.
+func FilterNoAlloc[T any](dst []T, source []T, filterFunc func(item T) bool) []T {
+	result := dst[:0]
+	for _, item := range source {
+		if filterFunc(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// MapNoAlloc appends the transformed items to dst[:0] and returns it.
+// Reusing dst across calls avoids allocating once its capacity suffices.
+func MapNoAlloc[T1 any, T2 any](dst []T2, source []T1, transform func(item T1) T2) []T2 {
+	result := dst[:0]
+	for _, item := range source {
+		result = append(result, transform(item))
+	}
+	return result
+}
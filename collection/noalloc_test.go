@@ -0,0 +1,71 @@
+package collection
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterNoAlloc(t *testing.T) {
+	t.Run("Success_reuses_destination", func(t *testing.T) {
+		dst := make([]int, 0, 8)
+
+		result := FilterNoAlloc(dst, []int{1, 2, 3, 4}, func(item int) bool { return item%2 == 0 })
+
+		assert.Equal(t, []int{2, 4}, result)
+		assert.Equal(t, cap(dst), cap(result))
+	})
+
+	t.Run("Success_nil_destination", func(t *testing.T) {
+		result := FilterNoAlloc(nil, []int{1, 2, 3}, func(item int) bool { return item > 1 })
+
+		assert.Equal(t, []int{2, 3}, result)
+	})
+
+	t.Run("Success_no_allocations", func(t *testing.T) {
+		source := []int{1, 2, 3, 4, 5, 6}
+		dst := make([]int, 0, len(source))
+
+		allocs := testing.AllocsPerRun(100, func() {
+			dst = FilterNoAlloc(dst, source, func(item int) bool { return item > 2 })
+		})
+
+		assert.Equal(t, 0.0, allocs)
+	})
+}
+
+func TestMapNoAlloc(t *testing.T) {
+	t.Run("Success_overwrites_previous_content", func(t *testing.T) {
+		dst := []string{"old", "old", "old"}
+
+		result := MapNoAlloc(dst, []int{1, 2}, strconv.Itoa)
+
+		assert.Equal(t, []string{"1", "2"}, result)
+	})
+
+	t.Run("Success_no_allocations", func(t *testing.T) {
+		source := []int{1, 2, 3, 4}
+		dst := make([]int, 0, len(source))
+
+		allocs := testing.AllocsPerRun(100, func() {
+			dst = MapNoAlloc(dst, source, func(item int) int { return item * item })
+		})
+
+		assert.Equal(t, 0.0, allocs)
+		assert.Equal(t, []int{1, 4, 9, 16}, dst)
+	})
+}
+
+func BenchmarkFilterNoAlloc(b *testing.B) {
+	source := make([]int, 1024)
+	for i := range source {
+		source[i] = i
+	}
+	dst := make([]int, 0, len(source))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		dst = FilterNoAlloc(dst, source, func(item int) bool { return item%3 == 0 })
+	}
+}
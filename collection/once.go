@@ -0,0 +1,69 @@
+package collection
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// SeenStore records the IDs of items whose side effects have already been performed.
+// Implementations can be backed by a map, a database table or a Redis set, so that reruns of a
+// side-effecting loop skip work done by earlier runs.
+type SeenStore[K comparable] interface {
+	// Seen reports whether the ID has been recorded.
+	Seen(id K) (bool, error)
+	// MarkSeen records the ID.
+	MarkSeen(id K) error
+}
+
+// MemorySeenStore is an in-memory SeenStore. It is safe for concurrent use.
+type MemorySeenStore[K comparable] struct {
+	mu   sync.RWMutex
+	seen map[K]struct{}
+}
+
+// NewMemorySeenStore creates an empty MemorySeenStore.
+func NewMemorySeenStore[K comparable]() *MemorySeenStore[K] {
+	return &MemorySeenStore[K]{seen: make(map[K]struct{})}
+}
+
+// Seen reports whether the ID has been recorded.
+func (s *MemorySeenStore[K]) Seen(id K) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, exists := s.seen[id]
+	return exists, nil
+}
+
+// MarkSeen records the ID.
+func (s *MemorySeenStore[K]) MarkSeen(id K) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.seen[id] = struct{}{}
+	return nil
+}
+
+// ForEachOnce executes the action for each item whose ID is not yet in the store, recording the ID
+// after the action succeeds. Items already seen, including duplicates within the list, are skipped.
+// It stops at the first error, wrapped with the index of the failing item; that item is not recorded,
+// so a rerun retries it.
+func ForEachOnce[T any, K comparable](source []T, idFunc func(item T) K, store SeenStore[K], action func(item T) error) error {
+	for idx, item := range source {
+		id := idFunc(item)
+		seen, err := store.Seen(id)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error checking seen store at index:'%v', error", idx))
+		}
+		if seen {
+			continue
+		}
+		if err := action(item); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error processing at index:'%v', error", idx))
+		}
+		if err := store.MarkSeen(id); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error recording seen id at index:'%v', error", idx))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type brokenSeenStore struct{}
+
+func (brokenSeenStore) Seen(id string) (bool, error) { return false, errors.New("connection refused") }
+func (brokenSeenStore) MarkSeen(id string) error     { return nil }
+
+func TestForEachOnce(t *testing.T) {
+	type email struct {
+		ID string
+		To string
+	}
+	emails := []email{{"m1", "a@x"}, {"m2", "b@x"}, {"m1", "a@x"}, {"m3", "c@x"}}
+	idFunc := func(e email) string { return e.ID }
+
+	t.Run("Success_skips_duplicates_and_reruns", func(t *testing.T) {
+		store := NewMemorySeenStore[string]()
+		sent := []string{}
+		send := func(e email) error {
+			sent = append(sent, e.ID)
+			return nil
+		}
+
+		assert.NoError(t, ForEachOnce(emails, idFunc, store, send))
+		assert.NoError(t, ForEachOnce(emails, idFunc, store, send))
+
+		assert.Equal(t, []string{"m1", "m2", "m3"}, sent)
+	})
+
+	t.Run("Error_failed_item_is_retried", func(t *testing.T) {
+		store := NewMemorySeenStore[string]()
+		sent := []string{}
+		failing := true
+		send := func(e email) error {
+			if e.ID == "m2" && failing {
+				return errors.New("smtp timeout")
+			}
+			sent = append(sent, e.ID)
+			return nil
+		}
+
+		err := ForEachOnce(emails, idFunc, store, send)
+		assert.EqualError(t, err, "error processing at index:'1', error: smtp timeout")
+
+		failing = false
+		assert.NoError(t, ForEachOnce(emails, idFunc, store, send))
+		assert.Equal(t, []string{"m1", "m2", "m3"}, sent)
+	})
+
+	t.Run("Error_store", func(t *testing.T) {
+		err := ForEachOnce(emails, idFunc, SeenStore[string](brokenSeenStore{}), func(e email) error { return nil })
+
+		assert.EqualError(t, err, "error checking seen store at index:'0', error: connection refused")
+	})
+}
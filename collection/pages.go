@@ -0,0 +1,31 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// FoldPages drives cursor-based pagination: it calls fetch starting with an empty cursor, passes each page
+// to accumulate, and continues with the returned cursor until it is empty. It stops at the first error,
+// wrapped with the page number, or when the context is cancelled between pages.
+func FoldPages[T any](ctx context.Context, fetch func(cursor string) (items []T, next string, err error), accumulate func(items []T) error) error {
+	cursor := ""
+	for page := 0; ; page++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		items, next, err := fetch(cursor)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error fetching page:'%v', error", page))
+		}
+		if err := accumulate(items); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error accumulating page:'%v', error", page))
+		}
+		if next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
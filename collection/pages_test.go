@@ -0,0 +1,77 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedSource serves items in pages of the given size, using the next offset as cursor.
+func pagedSource(items []int, size int) func(cursor string) ([]int, string, error) {
+	return func(cursor string) ([]int, string, error) {
+		offset := 0
+		if cursor != "" {
+			offset, _ = strconv.Atoi(cursor)
+		}
+		end := offset + size
+		if end >= len(items) {
+			return SafeSlice(items, offset, len(items)), "", nil
+		}
+		return items[offset:end], strconv.Itoa(end), nil
+	}
+}
+
+func TestFoldPages(t *testing.T) {
+	t.Run("Success_all_pages", func(t *testing.T) {
+		collected := []int{}
+		pages := 0
+
+		err := FoldPages(context.Background(), pagedSource([]int{1, 2, 3, 4, 5, 6, 7}, 3), func(items []int) error {
+			pages++
+			collected = append(collected, Map(items, func(item int) int { return item * 10 })...)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, pages)
+		assert.Equal(t, []int{10, 20, 30, 40, 50, 60, 70}, collected)
+	})
+
+	t.Run("Error_fetch", func(t *testing.T) {
+		fetch := func(cursor string) ([]int, string, error) {
+			if cursor == "" {
+				return []int{1}, "next", nil
+			}
+			return nil, "", errors.New("rate limited")
+		}
+
+		err := FoldPages(context.Background(), fetch, func(items []int) error { return nil })
+
+		assert.EqualError(t, err, "error fetching page:'1', error: rate limited")
+	})
+
+	t.Run("Error_accumulate", func(t *testing.T) {
+		err := FoldPages(context.Background(), pagedSource([]int{1, 2}, 1), func(items []int) error {
+			return errors.New("store unavailable")
+		})
+
+		assert.EqualError(t, err, "error accumulating page:'0', error: store unavailable")
+	})
+
+	t.Run("Error_context_cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		pages := 0
+
+		err := FoldPages(ctx, pagedSource([]int{1, 2, 3, 4}, 1), func(items []int) error {
+			pages++
+			cancel()
+			return nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, pages)
+	})
+}
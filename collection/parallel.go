@@ -0,0 +1,93 @@
+package collection
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// SumParallel returns the sum of elements in a slice, splitting it into contiguous chunks summed concurrently.
+// Workers below 1 are treated as 1. Float results may differ from Sum in the last bits since the
+// addition order changes.
+func SumParallel[T Summable](list []T, workers int) T {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(list) {
+		workers = len(list)
+	}
+	if workers <= 1 {
+		return Sum(list)
+	}
+	partials := make([]T, workers)
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		from := worker * len(list) / workers
+		to := (worker + 1) * len(list) / workers
+		wg.Add(1)
+		go func(worker int, chunk []T) {
+			defer wg.Done()
+			partials[worker] = Sum(chunk)
+		}(worker, list[from:to])
+	}
+	wg.Wait()
+	return Sum(partials)
+}
+
+// ParallelForEachWithError executes the action for each item on the given number of workers.
+// The first error, wrapped with the index of the failing item, cancels the context passed to the
+// actions and stops handing out remaining items; ParallelForEachWithError still waits for running
+// actions to return; no action starts once the context is cancelled. If the parent context ends first,
+// its error is returned.
+// Workers below 1 are treated as 1.
+func ParallelForEachWithError[T any](ctx context.Context, source []T, workers int, action func(ctx context.Context, item T) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var once sync.Once
+	var firstErr error
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for worker := 0; worker < max(min(workers, len(source)), 1); worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				if err := action(ctx, source[idx]); err != nil {
+					fail(errors.Wrap(err, fmt.Sprintf("error processing at index:'%v', error", idx)))
+				}
+			}
+		}()
+	}
+
+dispatch:
+	for idx := range source {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case jobs <- idx:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,123 @@
+package collection
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumParallel(t *testing.T) {
+	large := make([]int, 10001)
+	for i := range large {
+		large[i] = i
+	}
+
+	tests := []struct {
+		name     string
+		list     []int
+		workers  int
+		expected int
+	}{
+		{name: "several workers", list: large, workers: 4, expected: 50005000},
+		{name: "uneven chunks", list: []int{1, 2, 3, 4, 5, 6, 7}, workers: 3, expected: 28},
+		{name: "five items on four workers", list: []int{1, 2, 3, 4, 5}, workers: 4, expected: 15},
+		{name: "ten items on four workers", list: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, workers: 4, expected: 55},
+		{name: "more workers than items", list: []int{1, 2}, workers: 8, expected: 3},
+		{name: "zero workers", list: []int{1, 2, 3}, workers: 0, expected: 6},
+		{name: "empty list", list: []int{}, workers: 4, expected: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SumParallel(tc.list, tc.workers))
+		})
+	}
+
+	t.Run("floats", func(t *testing.T) {
+		assert.InDelta(t, 16.5, SumParallel([]float64{1.1, 2.2, 3.3, 4.4, 5.5}, 2), 1e-9)
+	})
+}
+
+func BenchmarkSumParallel(b *testing.B) {
+	list := make([]float64, 1<<20)
+	for i := range list {
+		list[i] = float64(i)
+	}
+
+	for i := 0; i < b.N; i++ {
+		SumParallel(list, 8)
+	}
+}
+
+func TestParallelForEachWithError(t *testing.T) {
+	t.Run("Success_processes_all_items", func(t *testing.T) {
+		var total int64
+
+		err := ParallelForEachWithError(context.Background(), []int{1, 2, 3, 4, 5}, 3, func(ctx context.Context, item int) error {
+			atomic.AddInt64(&total, int64(item))
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(15), total)
+	})
+
+	t.Run("Error_cancels_remaining_work", func(t *testing.T) {
+		source := make([]int, 100)
+		for i := range source {
+			source[i] = i
+		}
+		var started int32
+
+		err := ParallelForEachWithError(context.Background(), source, 2, func(ctx context.Context, item int) error {
+			atomic.AddInt32(&started, 1)
+			if item == 3 {
+				return errors.New("boom")
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(5 * time.Millisecond):
+			}
+			return nil
+		})
+
+		assert.EqualError(t, err, "error processing at index:'3', error: boom")
+		assert.Less(t, atomic.LoadInt32(&started), int32(100))
+	})
+
+	t.Run("Error_no_action_after_cancel", func(t *testing.T) {
+		var started int32
+
+		err := ParallelForEachWithError(context.Background(), []int{0, 1, 2, 3}, 1, func(ctx context.Context, item int) error {
+			atomic.AddInt32(&started, 1)
+			return errors.New("boom")
+		})
+
+		assert.EqualError(t, err, "error processing at index:'0', error: boom")
+		assert.Equal(t, int32(1), atomic.LoadInt32(&started))
+	})
+
+	t.Run("Error_parent_cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		var started int32
+
+		err := ParallelForEachWithError(ctx, []int{1, 2, 3}, 2, func(ctx context.Context, item int) error {
+			atomic.AddInt32(&started, 1)
+			return nil
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Zero(t, atomic.LoadInt32(&started))
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		err := ParallelForEachWithError(context.Background(), []int{}, 4, func(ctx context.Context, item int) error { return nil })
+
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,38 @@
+package collection
+
+import "sync"
+
+// BufferPool recycles temporary slices between calls, backed by a sync.Pool.
+// It is safe for concurrent use; the zero value is ready to use.
+type BufferPool[T any] struct {
+	pool sync.Pool
+}
+
+// NewBufferPool creates an empty BufferPool.
+func NewBufferPool[T any]() *BufferPool[T] {
+	return &BufferPool[T]{}
+}
+
+// Get returns an empty slice with at least the given capacity, reusing a pooled buffer when one is large enough.
+func (p *BufferPool[T]) Get(capacity int) []T {
+	if pooled, ok := p.pool.Get().(*[]T); ok {
+		if cap(*pooled) >= capacity {
+			return (*pooled)[:0]
+		}
+		p.pool.Put(pooled)
+	}
+	return make([]T, 0, capacity)
+}
+
+// Put returns a buffer to the pool. The caller must not use the buffer afterwards.
+func (p *BufferPool[T]) Put(buffer []T) {
+	if cap(buffer) == 0 {
+		return
+	}
+	var zero T
+	for i := range buffer {
+		buffer[i] = zero
+	}
+	buffer = buffer[:0]
+	p.pool.Put(&buffer)
+}
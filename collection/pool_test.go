@@ -0,0 +1,58 @@
+package collection
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferPool(t *testing.T) {
+	t.Run("Success_get_empty_buffer_with_capacity", func(t *testing.T) {
+		pool := NewBufferPool[int]()
+
+		buffer := pool.Get(16)
+
+		assert.Equal(t, 0, len(buffer))
+		assert.GreaterOrEqual(t, cap(buffer), 16)
+	})
+
+	t.Run("Success_put_then_get_is_empty", func(t *testing.T) {
+		pool := NewBufferPool[string]()
+
+		buffer := append(pool.Get(4), "a", "b")
+		pool.Put(buffer)
+		reused := pool.Get(2)
+
+		assert.Equal(t, 0, len(reused))
+		assert.GreaterOrEqual(t, cap(reused), 2)
+	})
+
+	t.Run("Success_too_small_buffer_is_not_returned", func(t *testing.T) {
+		pool := NewBufferPool[int]()
+
+		pool.Put(make([]int, 0, 2))
+		buffer := pool.Get(64)
+
+		assert.GreaterOrEqual(t, cap(buffer), 64)
+	})
+
+	t.Run("Success_zero_value_and_concurrent_use", func(t *testing.T) {
+		var pool BufferPool[int]
+		var wg sync.WaitGroup
+
+		for worker := 0; worker < 8; worker++ {
+			wg.Add(1)
+			go func(worker int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					buffer := pool.Get(8)
+					buffer = append(buffer, worker, i)
+					assert.Equal(t, []int{worker, i}, buffer)
+					pool.Put(buffer)
+				}
+			}(worker)
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,88 @@
+package collection
+
+import (
+	"cmp"
+	"container/heap"
+)
+
+// PriorityQueue is a binary heap popping the item with the highest priority first.
+// Items with equal priority are popped in insertion order. It is not safe for concurrent use.
+type PriorityQueue[T any, P cmp.Ordered] struct {
+	entries priorityEntries[T, P]
+	pushed  int
+}
+
+type priorityEntry[T any, P cmp.Ordered] struct {
+	item     T
+	priority P
+	seq      int
+}
+
+type priorityEntries[T any, P cmp.Ordered] []priorityEntry[T, P]
+
+func (e priorityEntries[T, P]) Len() int { return len(e) }
+func (e priorityEntries[T, P]) Less(i, j int) bool {
+	if e[i].priority != e[j].priority {
+		return e[i].priority > e[j].priority
+	}
+	return e[i].seq < e[j].seq
+}
+func (e priorityEntries[T, P]) Swap(i, j int) { e[i], e[j] = e[j], e[i] }
+func (e *priorityEntries[T, P]) Push(x any)   { *e = append(*e, x.(priorityEntry[T, P])) }
+func (e *priorityEntries[T, P]) Pop() any {
+	old := *e
+	last := old[len(old)-1]
+	*e = old[:len(old)-1]
+	return last
+}
+
+// NewPriorityQueue creates an empty PriorityQueue.
+func NewPriorityQueue[T any, P cmp.Ordered]() *PriorityQueue[T, P] {
+	return &PriorityQueue[T, P]{}
+}
+
+// Push adds an item with the given priority.
+func (q *PriorityQueue[T, P]) Push(item T, priority P) {
+	heap.Push(&q.entries, priorityEntry[T, P]{item: item, priority: priority, seq: q.pushed})
+	q.pushed++
+}
+
+// Pop removes and returns the item with the highest priority. ok is false when the queue is empty.
+func (q *PriorityQueue[T, P]) Pop() (item T, priority P, ok bool) {
+	if len(q.entries) == 0 {
+		return item, priority, false
+	}
+	entry := heap.Pop(&q.entries).(priorityEntry[T, P])
+	return entry.item, entry.priority, true
+}
+
+// Len returns the number of queued items.
+func (q *PriorityQueue[T, P]) Len() int {
+	return len(q.entries)
+}
+
+// ForEachByPriority executes the action for each item, highest priority first.
+// Items with equal priority keep their order in the list.
+func ForEachByPriority[T any, P cmp.Ordered](source []T, priorityFunc func(item T) P, action func(item T)) {
+	queue := NewPriorityQueue[int, P]()
+	for idx, item := range source {
+		queue.Push(idx, priorityFunc(item))
+	}
+	for idx, _, ok := queue.Pop(); ok; idx, _, ok = queue.Pop() {
+		action(source[idx])
+	}
+}
+
+// MapByPriority applies the transformation to each item, highest priority first, and returns the
+// results in the original order of the list.
+func MapByPriority[T1 any, T2 any, P cmp.Ordered](source []T1, priorityFunc func(item T1) P, transform func(item T1) T2) []T2 {
+	result := make([]T2, len(source))
+	queue := NewPriorityQueue[int, P]()
+	for idx, item := range source {
+		queue.Push(idx, priorityFunc(item))
+	}
+	for idx, _, ok := queue.Pop(); ok; idx, _, ok = queue.Pop() {
+		result[idx] = transform(source[idx])
+	}
+	return result
+}
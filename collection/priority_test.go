@@ -0,0 +1,61 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type priorityJob struct {
+	Name     string
+	Priority int
+}
+
+func TestPriorityQueue(t *testing.T) {
+	t.Run("Success_highest_first_and_stable", func(t *testing.T) {
+		queue := NewPriorityQueue[string, int]()
+		queue.Push("low", 1)
+		queue.Push("high-a", 5)
+		queue.Push("mid", 3)
+		queue.Push("high-b", 5)
+
+		popped := []string{}
+		for queue.Len() > 0 {
+			item, _, _ := queue.Pop()
+			popped = append(popped, item)
+		}
+
+		assert.Equal(t, []string{"high-a", "high-b", "mid", "low"}, popped)
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		_, _, ok := NewPriorityQueue[string, int]().Pop()
+
+		assert.False(t, ok)
+	})
+}
+
+func TestForEachByPriority(t *testing.T) {
+	jobs := []priorityJob{{"report", 1}, {"payment", 10}, {"email", 5}, {"refund", 10}}
+	priority := func(job priorityJob) int { return job.Priority }
+
+	t.Run("Success_processes_in_priority_order", func(t *testing.T) {
+		order := []string{}
+
+		ForEachByPriority(jobs, priority, func(job priorityJob) { order = append(order, job.Name) })
+
+		assert.Equal(t, []string{"payment", "refund", "email", "report"}, order)
+	})
+
+	t.Run("Success_map_keeps_original_order", func(t *testing.T) {
+		order := []string{}
+
+		result := MapByPriority(jobs, priority, func(job priorityJob) string {
+			order = append(order, job.Name)
+			return job.Name + "-done"
+		})
+
+		assert.Equal(t, []string{"payment", "refund", "email", "report"}, order)
+		assert.Equal(t, []string{"report-done", "payment-done", "email-done", "refund-done"}, result)
+	})
+}
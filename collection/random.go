@@ -0,0 +1,35 @@
+package collection
+
+import "math/rand"
+
+// randIntn draws from rng, or from the shared math/rand source when rng is nil.
+func randIntn(rng *rand.Rand, n int) int {
+	if rng == nil {
+		return rand.Intn(n)
+	}
+	return rng.Intn(n)
+}
+
+// Shuffle returns a copy of the list in random order. Passing a seeded rng, e.g.
+// rand.New(rand.NewSource(42)), makes the order reproducible; a nil rng uses the shared source.
+func Shuffle[T any](source []T, rng *rand.Rand) []T {
+	result := CloneList(source)
+	for i := len(result) - 1; i > 0; i-- {
+		j := randIntn(rng, i+1)
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+// Sample returns n items picked at random without replacement, in random order. If the list has
+// fewer than n items, all of them are returned shuffled. A seeded rng makes the sample reproducible;
+// a nil rng uses the shared source.
+func Sample[T any](source []T, n int, rng *rand.Rand) []T {
+	n = max(min(n, len(source)), 0)
+	pool := CloneList(source)
+	for i := 0; i < n; i++ {
+		j := i + randIntn(rng, len(pool)-i)
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	return pool[:n:n]
+}
@@ -0,0 +1,45 @@
+package collection
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffle(t *testing.T) {
+	source := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+	t.Run("Success_same_seed_same_order", func(t *testing.T) {
+		first := Shuffle(source, rand.New(rand.NewSource(42)))
+		second := Shuffle(source, rand.New(rand.NewSource(42)))
+
+		assert.Equal(t, first, second)
+		assert.ElementsMatch(t, source, first)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, source)
+	})
+
+	t.Run("Success_nil_rng", func(t *testing.T) {
+		assert.ElementsMatch(t, source, Shuffle(source, nil))
+		assert.Empty(t, Shuffle([]int{}, nil))
+	})
+}
+
+func TestSample(t *testing.T) {
+	source := []string{"a", "b", "c", "d", "e"}
+
+	t.Run("Success_reproducible_without_replacement", func(t *testing.T) {
+		first := Sample(source, 3, rand.New(rand.NewSource(7)))
+		second := Sample(source, 3, rand.New(rand.NewSource(7)))
+
+		assert.Equal(t, first, second)
+		assert.Len(t, first, 3)
+		assert.Len(t, Distinct(first), 3)
+		assert.Subset(t, source, first)
+	})
+
+	t.Run("Success_n_larger_than_list", func(t *testing.T) {
+		assert.ElementsMatch(t, source, Sample(source, 10, nil))
+		assert.Empty(t, Sample(source, -1, nil))
+	})
+}
@@ -0,0 +1,37 @@
+package collection
+
+// PadRight returns a copy of the list extended to the given length by appending fill.
+// Lists already at or above the length are returned as a copy unchanged.
+func PadRight[T any](source []T, length int, fill T) []T {
+	result := CloneList(source)
+	for len(result) < length {
+		result = append(result, fill)
+	}
+	return result
+}
+
+// PadLeft returns a copy of the list extended to the given length by prepending fill.
+// Lists already at or above the length are returned as a copy unchanged.
+func PadLeft[T any](source []T, length int, fill T) []T {
+	if len(source) >= length {
+		return CloneList(source)
+	}
+	result := make([]T, length)
+	padding := length - len(source)
+	for i := 0; i < padding; i++ {
+		result[i] = fill
+	}
+	copy(result[padding:], source)
+	return result
+}
+
+// Truncate returns a copy of at most the first n items of the list.
+func Truncate[T any](source []T, n int) []T {
+	if n < 0 {
+		n = 0
+	}
+	if n > len(source) {
+		n = len(source)
+	}
+	return CloneList(source[:n])
+}
@@ -0,0 +1,76 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPadRight(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   []int
+		length   int
+		expected []int
+	}{
+		{name: "pad shorter list", source: []int{1, 2}, length: 4, expected: []int{1, 2, 0, 0}},
+		{name: "exact length", source: []int{1, 2}, length: 2, expected: []int{1, 2}},
+		{name: "longer list is unchanged", source: []int{1, 2, 3}, length: 2, expected: []int{1, 2, 3}},
+		{name: "nil list", source: nil, length: 2, expected: []int{0, 0}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, PadRight(tc.source, tc.length, 0))
+		})
+	}
+}
+
+func TestPadLeft(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   []string
+		length   int
+		expected []string
+	}{
+		{name: "pad shorter list", source: []string{"a", "b"}, length: 4, expected: []string{"-", "-", "a", "b"}},
+		{name: "exact length", source: []string{"a"}, length: 1, expected: []string{"a"}},
+		{name: "longer list is unchanged", source: []string{"a", "b"}, length: 1, expected: []string{"a", "b"}},
+		{name: "empty list", source: []string{}, length: 0, expected: []string{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, PadLeft(tc.source, tc.length, "-"))
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   []int
+		n        int
+		expected []int
+	}{
+		{name: "truncate", source: []int{1, 2, 3, 4}, n: 2, expected: []int{1, 2}},
+		{name: "n above length", source: []int{1, 2}, n: 5, expected: []int{1, 2}},
+		{name: "zero", source: []int{1, 2}, n: 0, expected: []int{}},
+		{name: "negative", source: []int{1, 2}, n: -1, expected: []int{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Truncate(tc.source, tc.n))
+		})
+	}
+
+	t.Run("result does not share memory", func(t *testing.T) {
+		source := []int{1, 2, 3}
+
+		result := Truncate(source, 2)
+		result[0] = 100
+
+		assert.Equal(t, []int{1, 2, 3}, source)
+	})
+}
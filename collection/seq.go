@@ -0,0 +1,81 @@
+package collection
+
+import "iter"
+
+// Cycle returns an infinite sequence repeating the items of the list in order.
+// An empty list yields an empty sequence. Bound it with TakeSeq.
+func Cycle[T any](source []T) iter.Seq[T] {
+	items := CloneList(source)
+	return func(yield func(T) bool) {
+		if len(items) == 0 {
+			return
+		}
+		for {
+			for _, item := range items {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Iterate returns an infinite sequence seed, f(seed), f(f(seed)), ... Bound it with TakeSeq.
+func Iterate[T any](seed T, f func(item T) T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		current := seed
+		for yield(current) {
+			current = f(current)
+		}
+	}
+}
+
+// TakeSeq returns a sequence yielding at most the first n items of the given sequence.
+func TakeSeq[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for item := range seq {
+			if !yield(item) {
+				return
+			}
+			taken++
+			if taken >= n {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq2 lazily applies a transformation function to each value of a key/value sequence, preserving keys.
+func MapSeq2[K any, V1 any, V2 any](seq iter.Seq2[K, V1], mappingFunc func(key K, value V1) V2) iter.Seq2[K, V2] {
+	return func(yield func(K, V2) bool) {
+		for key, value := range seq {
+			if !yield(key, mappingFunc(key, value)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq2 lazily filters a key/value sequence based on a provided function.
+func FilterSeq2[K any, V any](seq iter.Seq2[K, V], filteringFunc func(key K, value V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for key, value := range seq {
+			if filteringFunc(key, value) && !yield(key, value) {
+				return
+			}
+		}
+	}
+}
+
+// ReduceSeq2 reduces a key/value sequence to a single value using the provided function.
+func ReduceSeq2[K any, V any, A any](seq iter.Seq2[K, V], reduceFunc func(acc A, key K, value V) A, initialValue A) A {
+	acc := initialValue
+	for key, value := range seq {
+		acc = reduceFunc(acc, key, value)
+	}
+	return acc
+}
@@ -0,0 +1,134 @@
+package collection
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCycle(t *testing.T) {
+	t.Run("Success_round_robin", func(t *testing.T) {
+		result := slices.Collect(TakeSeq(Cycle([]string{"a", "b", "c"}), 7))
+
+		assert.Equal(t, []string{"a", "b", "c", "a", "b", "c", "a"}, result)
+	})
+
+	t.Run("Success_empty_list", func(t *testing.T) {
+		result := slices.Collect(TakeSeq(Cycle([]int{}), 3))
+
+		assert.Empty(t, result)
+	})
+
+	t.Run("Success_source_copied", func(t *testing.T) {
+		source := []int{1, 2}
+		seq := Cycle(source)
+		source[0] = 100
+
+		assert.Equal(t, []int{1, 2, 1}, slices.Collect(TakeSeq(seq, 3)))
+	})
+}
+
+func TestIterate(t *testing.T) {
+	t.Run("Success_powers_of_two", func(t *testing.T) {
+		result := slices.Collect(TakeSeq(Iterate(1, func(item int) int { return item * 2 }), 5))
+
+		assert.Equal(t, []int{1, 2, 4, 8, 16}, result)
+	})
+
+	t.Run("Success_zero_take", func(t *testing.T) {
+		calls := 0
+		result := slices.Collect(TakeSeq(Iterate(1, func(item int) int {
+			calls++
+			return item + 1
+		}), 0))
+
+		assert.Empty(t, result)
+		assert.Equal(t, 0, calls)
+	})
+}
+
+func TestTakeSeq(t *testing.T) {
+	t.Run("Success_shorter_sequence", func(t *testing.T) {
+		result := slices.Collect(TakeSeq(slices.Values([]int{1, 2}), 5))
+
+		assert.Equal(t, []int{1, 2}, result)
+	})
+
+	t.Run("Success_early_break", func(t *testing.T) {
+		result := []int{}
+		for item := range TakeSeq(Iterate(0, func(item int) int { return item + 1 }), 10) {
+			if item == 3 {
+				break
+			}
+			result = append(result, item)
+		}
+
+		assert.Equal(t, []int{0, 1, 2}, result)
+	})
+}
+
+func TestMapSeq2(t *testing.T) {
+	t.Run("Success_map_values", func(t *testing.T) {
+		source := map[string]int{"a": 1, "b": 2}
+
+		result := maps.Collect(MapSeq2(maps.All(source), func(key string, value int) string {
+			return key + strconv.Itoa(value)
+		}))
+
+		assert.Equal(t, map[string]string{"a": "a1", "b": "b2"}, result)
+	})
+
+	t.Run("Success_indexed_slice", func(t *testing.T) {
+		result := []int{}
+		for _, value := range MapSeq2(slices.All([]int{10, 20, 30}), func(index int, value int) int { return index * value }) {
+			result = append(result, value)
+		}
+
+		assert.Equal(t, []int{0, 20, 60}, result)
+	})
+}
+
+func TestFilterSeq2(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		source := map[string]int{"a": 1, "b": 2, "c": 3, "d": 4}
+
+		result := maps.Collect(FilterSeq2(maps.All(source), func(key string, value int) bool { return value%2 == 0 }))
+
+		assert.Equal(t, map[string]int{"b": 2, "d": 4}, result)
+	})
+
+	t.Run("Success_early_break", func(t *testing.T) {
+		keys := []int{}
+		for index := range FilterSeq2(slices.All([]int{1, 2, 3, 4, 5, 6}), func(index int, value int) bool { return value > 1 }) {
+			if len(keys) == 2 {
+				break
+			}
+			keys = append(keys, index)
+		}
+
+		assert.Equal(t, []int{1, 2}, keys)
+	})
+}
+
+func TestReduceSeq2(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		source := map[string]int{"a": 1, "bb": 2, "ccc": 3}
+
+		result := ReduceSeq2(maps.All(source), func(acc int, key string, value int) int {
+			return acc + len(key)*value
+		}, 0)
+
+		assert.Equal(t, 14, result)
+	})
+
+	t.Run("Success_empty_sequence", func(t *testing.T) {
+		result := ReduceSeq2(maps.All(map[string]int{}), func(acc int, key string, value int) int {
+			return acc + value
+		}, 7)
+
+		assert.Equal(t, 7, result)
+	})
+}
@@ -0,0 +1,27 @@
+package collection
+
+// Union returns the distinct items of both lists, in the order they are first seen in a, then b.
+func Union[T comparable](a, b []T) []T {
+	return Distinct(append(CloneList(a), b...))
+}
+
+// Intersect returns the distinct items of a that are also in b, in the order they are first seen in a.
+func Intersect[T comparable](a, b []T) []T {
+	inB := membership(b)
+	return Distinct(Filter(a, func(item T) bool { return inB[item] }))
+}
+
+// Difference returns the distinct items of a that are not in b, in the order they are first seen in a.
+func Difference[T comparable](a, b []T) []T {
+	inB := membership(b)
+	return Distinct(Filter(a, func(item T) bool { return !inB[item] }))
+}
+
+// membership returns the presence map of the items of the list, like maps.SliceToHashMap.
+func membership[T comparable](list []T) map[T]bool {
+	result := make(map[T]bool, len(list))
+	for _, item := range list {
+		result[item] = true
+	}
+	return result
+}
@@ -0,0 +1,33 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetOperations(t *testing.T) {
+	a := []string{"c", "a", "b", "a", "d"}
+	b := []string{"e", "b", "c", "e"}
+
+	t.Run("Success_union", func(t *testing.T) {
+		assert.Equal(t, []string{"c", "a", "b", "d", "e"}, Union(a, b))
+		assert.Equal(t, []string{"c", "a", "b", "a", "d"}, a)
+	})
+
+	t.Run("Success_intersect", func(t *testing.T) {
+		assert.Equal(t, []string{"c", "b"}, Intersect(a, b))
+		assert.Equal(t, []string{"b", "c"}, Intersect(b, a))
+	})
+
+	t.Run("Success_difference", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "d"}, Difference(a, b))
+		assert.Equal(t, []string{"e"}, Difference(b, a))
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		assert.Equal(t, []int{}, Union([]int{}, []int{}))
+		assert.Equal(t, []int{}, Intersect([]int{1}, []int{}))
+		assert.Equal(t, []int{1}, Difference([]int{1, 1}, nil))
+	})
+}
@@ -0,0 +1,17 @@
+package collection
+
+// SplitBy splits the list into the segments separated by delimiter items, like strings.Split.
+// Delimiters are dropped, so a list with n delimiters always yields n+1 segments, some possibly empty.
+func SplitBy[T any](source []T, isDelimiter func(item T) bool) [][]T {
+	result := [][]T{}
+	segment := []T{}
+	for _, item := range source {
+		if isDelimiter(item) {
+			result = append(result, segment)
+			segment = []T{}
+			continue
+		}
+		segment = append(segment, item)
+	}
+	return append(result, segment)
+}
@@ -0,0 +1,29 @@
+package collection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitBy(t *testing.T) {
+	isZero := func(item int) bool { return item == 0 }
+
+	tests := []struct {
+		name     string
+		source   []int
+		expected [][]int
+	}{
+		{name: "split segments", source: []int{1, 2, 0, 3, 0, 4, 5}, expected: [][]int{{1, 2}, {3}, {4, 5}}},
+		{name: "no delimiter", source: []int{1, 2}, expected: [][]int{{1, 2}}},
+		{name: "consecutive delimiters", source: []int{1, 0, 0, 2}, expected: [][]int{{1}, {}, {2}}},
+		{name: "leading and trailing delimiters", source: []int{0, 1, 0}, expected: [][]int{{}, {1}, {}}},
+		{name: "empty list", source: []int{}, expected: [][]int{{}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, SplitBy(tc.source, isZero))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package collection
+
+import (
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// Integer includes all built-in signed and unsigned integer types.
+type Integer interface {
+	int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64 | uintptr
+}
+
+// SumChecked returns the sum of elements in a slice of integers,
+// or an error reporting the index at which the running total overflowed or underflowed.
+func SumChecked[T Integer](list []T) (T, error) {
+	var total T
+	for idx, v := range list {
+		next := total + v
+		if (v > 0 && next < total) || (v < 0 && next > total) {
+			return 0, errors.Errorf("integer overflow at index:'%v'", idx)
+		}
+		total = next
+	}
+	return total, nil
+}
+
+// SumBig returns the exact sum of elements in a slice of integers as a *big.Int.
+func SumBig[T Integer](list []T) *big.Int {
+	var zero T
+	unsigned := zero-1 > 0
+	total := new(big.Int)
+	item := new(big.Int)
+	for _, v := range list {
+		if unsigned {
+			item.SetUint64(uint64(v))
+		} else {
+			item.SetInt64(int64(v))
+		}
+		total.Add(total, item)
+	}
+	return total
+}
+
+// Numeric is implemented by value types supporting addition and comparison, such as decimal money types.
+type Numeric[T any] interface {
+	Add(other T) T
+	Cmp(other T) int
+}
+
+// SumBy returns the sum of the values selected from each item.
+func SumBy[T any, N Summable](list []T, selector func(item T) N) N {
+	var total N
+	for _, item := range list {
+		total += selector(item)
+	}
+	return total
+}
+
+// Average returns the arithmetic mean of a slice of summable types, or an error for an empty slice.
+func Average[T Summable](list []T) (float64, error) {
+	if len(list) == 0 {
+		return 0, errors.New("average of empty list")
+	}
+	return float64(Sum(list)) / float64(len(list)), nil
+}
+
+// CumulativeSum returns the running totals of a slice: the i-th result is the sum of the first i+1 items.
+func CumulativeSum[T Summable](list []T) []T {
+	return Scan(list, func(acc T, item T) T { return acc + item }, 0)
+}
+
+// Diffs returns the differences between adjacent items, list[i+1]-list[i]. It has one item less than
+// the list and is empty for lists shorter than two.
+func Diffs[T Summable](list []T) []T {
+	result := make([]T, 0, max(len(list)-1, 0))
+	for idx := 1; idx < len(list); idx++ {
+		result = append(result, list[idx]-list[idx-1])
+	}
+	return result
+}
+
+// WeightedSum returns the sum of each item's value multiplied by its weight.
+func WeightedSum[T any, V Summable, W Summable](list []T, valueFunc func(item T) V, weightFunc func(item T) W) float64 {
+	total := 0.0
+	for _, item := range list {
+		total += float64(valueFunc(item)) * float64(weightFunc(item))
+	}
+	return total
+}
+
+// WeightedAverage returns the mean of the items' values weighted by their weights, or an error when the
+// weights add up to zero, including for an empty list.
+func WeightedAverage[T any, V Summable, W Summable](list []T, valueFunc func(item T) V, weightFunc func(item T) W) (float64, error) {
+	weightedTotal, totalWeight := 0.0, 0.0
+	for _, item := range list {
+		weight := float64(weightFunc(item))
+		weightedTotal += float64(valueFunc(item)) * weight
+		totalWeight += weight
+	}
+	if totalWeight == 0 {
+		return 0, errors.New("weighted average with zero total weight")
+	}
+	return weightedTotal / totalWeight, nil
+}
+
+// SumNumeric returns the sum of elements in a slice of Numeric values, starting from zero.
+func SumNumeric[T Numeric[T]](list []T, zero T) T {
+	return Reduce(list, func(acc T, item T) T { return acc.Add(item) }, zero)
+}
+
+// SumByNumeric returns the sum of the Numeric values selected from each item, starting from zero.
+func SumByNumeric[T any, N Numeric[N]](list []T, selector func(item T) N, zero N) N {
+	total := zero
+	for _, item := range list {
+		total = total.Add(selector(item))
+	}
+	return total
+}
+
+// AverageNumeric returns the mean of a slice of Numeric values using divide to split the total
+// by the item count, or an error for an empty slice.
+func AverageNumeric[T Numeric[T]](list []T, zero T, divide func(total T, count int) T) (T, error) {
+	if len(list) == 0 {
+		return zero, errors.New("average of empty list")
+	}
+	return divide(SumNumeric(list, zero), len(list)), nil
+}
+
+// MaxNumeric returns the largest Numeric value in the slice and whether the slice is non-empty.
+func MaxNumeric[T Numeric[T]](list []T) (T, bool) {
+	return extremeNumeric(list, 1)
+}
+
+// MinNumeric returns the smallest Numeric value in the slice and whether the slice is non-empty.
+func MinNumeric[T Numeric[T]](list []T) (T, bool) {
+	return extremeNumeric(list, -1)
+}
+
+func extremeNumeric[T Numeric[T]](list []T, sign int) (T, bool) {
+	result, ok := First(list)
+	for _, item := range Tail(list) {
+		if item.Cmp(result)*sign > 0 {
+			result = item
+		}
+	}
+	return result, ok
+}
@@ -0,0 +1,214 @@
+package collection
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSumChecked(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		total, err := SumChecked([]int{1, 2, 3, -4})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, total)
+	})
+
+	t.Run("Success_empty_list", func(t *testing.T) {
+		total, err := SumChecked([]int64{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+	})
+
+	t.Run("Error_overflow", func(t *testing.T) {
+		total, err := SumChecked([]int64{1, math.MaxInt64 - 1, 1})
+
+		assert.Equal(t, int64(0), total)
+		assert.EqualError(t, err, "integer overflow at index:'2'")
+	})
+
+	t.Run("Error_underflow", func(t *testing.T) {
+		_, err := SumChecked([]int8{-100, -28, -1})
+
+		assert.EqualError(t, err, "integer overflow at index:'2'")
+	})
+
+	t.Run("Error_unsigned_overflow", func(t *testing.T) {
+		_, err := SumChecked([]uint8{200, 55, 1})
+
+		assert.EqualError(t, err, "integer overflow at index:'2'")
+	})
+
+	t.Run("Success_recovers_after_negative", func(t *testing.T) {
+		total, err := SumChecked([]int8{100, -50, 77})
+
+		assert.NoError(t, err)
+		assert.Equal(t, int8(127), total)
+	})
+}
+
+func TestSumBig(t *testing.T) {
+	t.Run("Success_beyond_int64", func(t *testing.T) {
+		total := SumBig([]int64{math.MaxInt64, math.MaxInt64, 2})
+
+		expected, _ := new(big.Int).SetString("18446744073709551616", 10)
+		assert.Equal(t, 0, expected.Cmp(total))
+	})
+
+	t.Run("Success_unsigned", func(t *testing.T) {
+		total := SumBig([]uint64{math.MaxUint64, 1})
+
+		expected, _ := new(big.Int).SetString("18446744073709551616", 10)
+		assert.Equal(t, 0, expected.Cmp(total))
+	})
+
+	t.Run("Success_negative", func(t *testing.T) {
+		assert.Equal(t, "-6", SumBig([]int{-1, -2, -3}).String())
+	})
+}
+
+// money is a minimal decimal-like type implementing Numeric.
+type money struct {
+	cents int64
+}
+
+func (m money) Add(other money) money { return money{cents: m.cents + other.cents} }
+
+func (m money) Cmp(other money) int {
+	switch {
+	case m.cents < other.cents:
+		return -1
+	case m.cents > other.cents:
+		return 1
+	}
+	return 0
+}
+
+func TestSumBy(t *testing.T) {
+	type line struct {
+		Qty   int
+		Price float64
+	}
+	lines := []line{{Qty: 2, Price: 1.5}, {Qty: 3, Price: 2}}
+
+	assert.Equal(t, 5, SumBy(lines, func(l line) int { return l.Qty }))
+	assert.Equal(t, 9.0, SumBy(lines, func(l line) float64 { return float64(l.Qty) * l.Price }))
+	assert.Equal(t, 0, SumBy([]line{}, func(l line) int { return l.Qty }))
+}
+
+func TestAverage(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		average, err := Average([]int{1, 2, 3, 4})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2.5, average)
+	})
+
+	t.Run("Error_empty_list", func(t *testing.T) {
+		_, err := Average([]float64{})
+
+		assert.EqualError(t, err, "average of empty list")
+	})
+}
+
+func TestCumulativeSumAndDiffs(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		balances := CumulativeSum([]int{100, -30, 50, -20})
+
+		assert.Equal(t, []int{100, 70, 120, 100}, balances)
+		assert.Equal(t, []int{-30, 50, -20}, Diffs(balances))
+	})
+
+	t.Run("Success_short_lists", func(t *testing.T) {
+		assert.Equal(t, []float64{}, CumulativeSum([]float64{}))
+		assert.Equal(t, []float64{}, Diffs([]float64{1.5}))
+	})
+}
+
+func TestWeighted(t *testing.T) {
+	type line struct {
+		Price    float64
+		Quantity int
+	}
+	lines := []line{{Price: 10, Quantity: 1}, {Price: 20, Quantity: 3}}
+	price := func(l line) float64 { return l.Price }
+	quantity := func(l line) int { return l.Quantity }
+
+	t.Run("Success_sum", func(t *testing.T) {
+		assert.Equal(t, 70.0, WeightedSum(lines, price, quantity))
+		assert.Equal(t, 0.0, WeightedSum([]line{}, price, quantity))
+	})
+
+	t.Run("Success_average", func(t *testing.T) {
+		average, err := WeightedAverage(lines, price, quantity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 17.5, average)
+	})
+
+	t.Run("Success_average_weighs_each_item_once", func(t *testing.T) {
+		calls := 0
+		countingQuantity := func(l line) int {
+			calls++
+			return l.Quantity
+		}
+
+		_, err := WeightedAverage(lines, price, countingQuantity)
+
+		assert.NoError(t, err)
+		assert.Equal(t, len(lines), calls)
+	})
+
+	t.Run("Error_zero_total_weight", func(t *testing.T) {
+		_, err := WeightedAverage([]line{{Price: 10, Quantity: 2}, {Price: 5, Quantity: -2}}, price, quantity)
+		assert.EqualError(t, err, "weighted average with zero total weight")
+
+		_, err = WeightedAverage([]line{}, price, quantity)
+		assert.EqualError(t, err, "weighted average with zero total weight")
+	})
+}
+
+func TestNumeric(t *testing.T) {
+	prices := []money{{cents: 150}, {cents: 250}, {cents: 100}}
+
+	t.Run("SumNumeric", func(t *testing.T) {
+		assert.Equal(t, money{cents: 500}, SumNumeric(prices, money{}))
+		assert.Equal(t, money{}, SumNumeric([]money{}, money{}))
+	})
+
+	t.Run("SumByNumeric", func(t *testing.T) {
+		type order struct {
+			Total money
+		}
+		orders := []order{{Total: money{cents: 10}}, {Total: money{cents: 5}}}
+
+		assert.Equal(t, money{cents: 15}, SumByNumeric(orders, func(o order) money { return o.Total }, money{}))
+	})
+
+	t.Run("AverageNumeric", func(t *testing.T) {
+		divide := func(total money, count int) money { return money{cents: total.cents / int64(count)} }
+
+		average, err := AverageNumeric(prices, money{}, divide)
+		assert.NoError(t, err)
+		assert.Equal(t, money{cents: 166}, average)
+
+		_, err = AverageNumeric([]money{}, money{}, divide)
+		assert.EqualError(t, err, "average of empty list")
+	})
+
+	t.Run("MaxNumeric_and_MinNumeric", func(t *testing.T) {
+		maximum, ok := MaxNumeric(prices)
+		assert.True(t, ok)
+		assert.Equal(t, money{cents: 250}, maximum)
+
+		minimum, ok := MinNumeric(prices)
+		assert.True(t, ok)
+		assert.Equal(t, money{cents: 100}, minimum)
+
+		_, ok = MaxNumeric([]money{})
+		assert.False(t, ok)
+	})
+}
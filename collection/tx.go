@@ -0,0 +1,28 @@
+package collection
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// ForEachChunkTx calls do for consecutive chunks of at most size items. If a chunk fails, rollback is
+// called for every chunk that was already processed, most recent first, giving all-or-nothing batch
+// semantics without a database transaction. The failing chunk itself is not rolled back; do is expected
+// to leave no trace when it returns an error. The error is wrapped with the index of the failing chunk.
+func ForEachChunkTx[T any](source []T, size int, do func(chunk []T) error, rollback func(chunk []T)) error {
+	if size < 1 {
+		return fmt.Errorf("chunk size must be positive, got %d", size)
+	}
+	done := [][]T{}
+	for _, chunk := range Chunk(source, size) {
+		if err := do(chunk); err != nil {
+			for idx := len(done) - 1; idx >= 0; idx-- {
+				rollback(done[idx])
+			}
+			return errors.Wrap(err, fmt.Sprintf("error processing chunk at index:'%v', error", len(done)))
+		}
+		done = append(done, chunk)
+	}
+	return nil
+}
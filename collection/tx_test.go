@@ -0,0 +1,44 @@
+package collection
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForEachChunkTx(t *testing.T) {
+	t.Run("Success_all_chunks", func(t *testing.T) {
+		processed := [][]int{}
+
+		err := ForEachChunkTx([]int{1, 2, 3, 4, 5}, 2, func(chunk []int) error {
+			processed = append(processed, chunk)
+			return nil
+		}, func(chunk []int) { t.Fatal("unexpected rollback") })
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, processed)
+	})
+
+	t.Run("Error_rolls_back_processed_chunks_in_reverse", func(t *testing.T) {
+		rolledBack := [][]int{}
+
+		err := ForEachChunkTx([]int{1, 2, 3, 4, 5, 6, 7}, 2, func(chunk []int) error {
+			if chunk[0] == 5 {
+				return errors.New("insert failed")
+			}
+			return nil
+		}, func(chunk []int) {
+			rolledBack = append(rolledBack, chunk)
+		})
+
+		assert.EqualError(t, err, "error processing chunk at index:'2', error: insert failed")
+		assert.Equal(t, [][]int{{3, 4}, {1, 2}}, rolledBack)
+	})
+
+	t.Run("Error_invalid_size", func(t *testing.T) {
+		err := ForEachChunkTx([]int{1}, 0, func(chunk []int) error { return nil }, func(chunk []int) {})
+
+		assert.EqualError(t, err, "chunk size must be positive, got 0")
+	})
+}
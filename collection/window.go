@@ -0,0 +1,35 @@
+package collection
+
+// SliceOption narrows the range of input items an operation reads, e.g. to preview the first rows
+// of a transformation without copying the input beforehand.
+type SliceOption func(*sliceOptions)
+
+type sliceOptions struct {
+	offset int
+	limit  int
+}
+
+// WithOffset skips the first n items of the input.
+func WithOffset(n int) SliceOption {
+	return func(o *sliceOptions) { o.offset = max(n, 0) }
+}
+
+// WithLimit reads at most n items of the input, after the offset.
+func WithLimit(n int) SliceOption {
+	return func(o *sliceOptions) { o.limit = max(n, 0) }
+}
+
+// ResolveSliceOptions returns the half-open range [start, end) of an input of the given length
+// selected by the options, clamped to the input. Without options the whole input is selected.
+func ResolveSliceOptions(length int, opts ...SliceOption) (start int, end int) {
+	options := sliceOptions{limit: -1}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	start = min(options.offset, length)
+	end = length
+	if options.limit >= 0 {
+		end = min(start+options.limit, length)
+	}
+	return start, end
+}
@@ -0,0 +1,53 @@
+package collection
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveSliceOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []SliceOption
+		start int
+		end   int
+	}{
+		{"no_options", nil, 0, 5},
+		{"limit", []SliceOption{WithLimit(2)}, 0, 2},
+		{"offset", []SliceOption{WithOffset(3)}, 3, 5},
+		{"offset_and_limit", []SliceOption{WithOffset(1), WithLimit(3)}, 1, 4},
+		{"beyond_length", []SliceOption{WithOffset(4), WithLimit(10)}, 4, 5},
+		{"offset_past_end", []SliceOption{WithOffset(9)}, 5, 5},
+		{"zero_limit", []SliceOption{WithLimit(0)}, 0, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			start, end := ResolveSliceOptions(5, test.opts...)
+
+			assert.Equal(t, test.start, start)
+			assert.Equal(t, test.end, end)
+		})
+	}
+}
+
+func TestMapWithSliceOptions(t *testing.T) {
+	t.Run("Success_preview", func(t *testing.T) {
+		calls := 0
+		result := Map([]int{1, 2, 3, 4, 5}, func(item int) int {
+			calls++
+			return item * 10
+		}, WithOffset(1), WithLimit(2))
+
+		assert.Equal(t, []int{20, 30}, result)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("Error_index_refers_to_full_list", func(t *testing.T) {
+		_, err := MapReturnWithError([]string{"1", "2", "x"}, strconv.Atoi, WithOffset(1))
+
+		assert.EqualError(t, err, "error mapping at index:'2', error: strconv.Atoi: parsing \"x\": invalid syntax")
+	})
+}
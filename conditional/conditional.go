@@ -1,5 +1,7 @@
 package conditional
 
+import "encoding/json"
+
 // Package utility provides utility functions for functional programming in Go.
 //
 // This file is part of golang-fp-utility.
@@ -37,3 +39,143 @@ func ForAll[T any](elements []T, condition func(T) bool) bool {
 	}
 	return true
 }
+
+// IsEmpty reports whether the list is nil or has no items.
+func IsEmpty[T any](list []T) bool {
+	return len(list) == 0
+}
+
+// IsEmptyMap reports whether the map is nil or has no entries.
+func IsEmptyMap[K comparable, V any](source map[K]V) bool {
+	return len(source) == 0
+}
+
+// IsZero reports whether the value equals the zero value of its type.
+func IsZero[T comparable](value T) bool {
+	var zero T
+	return value == zero
+}
+
+// NonEmptyOr returns the list when it has items, otherwise the fallback.
+// Example:
+//   - NonEmptyOr([]int{}, []int{0}) returns []int{0}.
+func NonEmptyOr[T any](list []T, fallback []T) []T {
+	return IfThen(IsEmpty(list), fallback, list)
+}
+
+// CondValue pairs a condition with the value selected when it holds.
+type CondValue[T any] struct {
+	Cond  bool
+	Value T
+}
+
+// When creates a CondValue.
+func When[T any](condition bool, value T) CondValue[T] {
+	return CondValue[T]{Cond: condition, Value: value}
+}
+
+// FirstMatch returns the value of the first pair whose condition holds, and whether any did.
+// Example:
+//   - FirstMatch(When(n < 0, "negative"), When(n == 0, "zero")) returns ("zero", true) for n == 0.
+func FirstMatch[T any](pairs ...CondValue[T]) (T, bool) {
+	for _, pair := range pairs {
+		if pair.Cond {
+			return pair.Value, true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// SelectCase returns the value of the first pair whose condition holds, or the fallback when none does.
+// It replaces nested IfThen calls.
+// Example:
+//   - SelectCase("positive", When(n < 0, "negative"), When(n == 0, "zero")) returns "positive" for n == 5.
+func SelectCase[T any](fallback T, pairs ...CondValue[T]) T {
+	value, ok := FirstMatch(pairs...)
+	return IfThen(ok, value, fallback)
+}
+
+// TriState is an optional boolean: True, False, or Unset when no value was provided.
+// FromPtrBool and the predicate methods can be passed directly to Map and Filter.
+type TriState int8
+
+const (
+	// Unset means no value was provided.
+	Unset TriState = iota
+	// True is a provided true value.
+	True
+	// False is a provided false value.
+	False
+)
+
+// FromBool converts a bool into True or False.
+func FromBool(value bool) TriState {
+	return IfThen(value, True, False)
+}
+
+// FromPtrBool converts a *bool into a TriState, mapping nil to Unset.
+func FromPtrBool(value *bool) TriState {
+	if value == nil {
+		return Unset
+	}
+	return FromBool(*value)
+}
+
+// Ptr converts the TriState back into a *bool, returning nil for Unset.
+func (t TriState) Ptr() *bool {
+	if t == Unset {
+		return nil
+	}
+	value := t == True
+	return &value
+}
+
+// OrElse returns the boolean value, or the fallback when Unset.
+func (t TriState) OrElse(fallback bool) bool {
+	if t == Unset {
+		return fallback
+	}
+	return t == True
+}
+
+// IsSet reports whether a value was provided.
+func (t TriState) IsSet() bool {
+	return t != Unset
+}
+
+// IsTrue reports whether the value is True.
+func (t TriState) IsTrue() bool {
+	return t == True
+}
+
+// IsFalse reports whether the value is False.
+func (t TriState) IsFalse() bool {
+	return t == False
+}
+
+// String returns "true", "false" or "unset".
+func (t TriState) String() string {
+	switch t {
+	case True:
+		return "true"
+	case False:
+		return "false"
+	}
+	return "unset"
+}
+
+// MarshalJSON encodes True and False as JSON booleans and Unset as null.
+func (t TriState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.Ptr())
+}
+
+// UnmarshalJSON decodes a JSON boolean, with null meaning Unset.
+func (t *TriState) UnmarshalJSON(data []byte) error {
+	var value *bool
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*t = FromPtrBool(value)
+	return nil
+}
@@ -18,9 +18,12 @@ package conditional
 // along with golang-fp-utility. If not, see <https://www.gnu.org/licenses/lgpl-3.0.txt>.
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
 )
 
 func TestIfThen(t *testing.T) {
@@ -138,3 +141,138 @@ func TestAll(t *testing.T) {
 	})
 
 }
+
+func TestIsEmpty(t *testing.T) {
+	assert.True(t, IsEmpty([]int(nil)))
+	assert.True(t, IsEmpty([]int{}))
+	assert.False(t, IsEmpty([]int{0}))
+
+	assert.True(t, IsEmptyMap(map[string]int(nil)))
+	assert.True(t, IsEmptyMap(map[string]int{}))
+	assert.False(t, IsEmptyMap(map[string]int{"a": 0}))
+}
+
+func TestIsZero(t *testing.T) {
+	type TempStruct struct {
+		Name  string
+		Value int
+	}
+
+	assert.True(t, IsZero(0))
+	assert.False(t, IsZero(1))
+	assert.True(t, IsZero(""))
+	assert.False(t, IsZero("a"))
+	assert.True(t, IsZero(TempStruct{}))
+	assert.False(t, IsZero(TempStruct{Value: 1}))
+	assert.True(t, IsZero[*int](nil))
+}
+
+func TestNonEmptyOr(t *testing.T) {
+	fallback := []string{"default"}
+
+	assert.Equal(t, fallback, NonEmptyOr(nil, fallback))
+	assert.Equal(t, fallback, NonEmptyOr([]string{}, fallback))
+	assert.Equal(t, []string{"a", "b"}, NonEmptyOr([]string{"a", "b"}, fallback))
+}
+
+func TestFirstMatch(t *testing.T) {
+	classify := func(n int) (string, bool) {
+		return FirstMatch(
+			When(n < 0, "negative"),
+			When(n == 0, "zero"),
+			When(n < 10, "small"),
+		)
+	}
+
+	result, ok := classify(-1)
+	assert.True(t, ok)
+	assert.Equal(t, "negative", result)
+
+	result, ok = classify(0)
+	assert.True(t, ok)
+	assert.Equal(t, "zero", result)
+
+	result, ok = classify(5)
+	assert.True(t, ok)
+	assert.Equal(t, "small", result)
+
+	result, ok = classify(50)
+	assert.False(t, ok)
+	assert.Equal(t, "", result)
+
+	_, ok = FirstMatch[int]()
+	assert.False(t, ok)
+}
+
+func TestSelectCase(t *testing.T) {
+	grade := func(score int) string {
+		return SelectCase("F",
+			When(score >= 80, "A"),
+			When(score >= 70, "B"),
+			When(score >= 60, "C"),
+		)
+	}
+
+	assert.Equal(t, "A", grade(95))
+	assert.Equal(t, "B", grade(70))
+	assert.Equal(t, "C", grade(65))
+	assert.Equal(t, "F", grade(10))
+}
+
+func TestTriState(t *testing.T) {
+	yes, no := true, false
+
+	t.Run("FromPtrBool_and_Ptr", func(t *testing.T) {
+		assert.Equal(t, True, FromPtrBool(&yes))
+		assert.Equal(t, False, FromPtrBool(&no))
+		assert.Equal(t, Unset, FromPtrBool(nil))
+
+		assert.Equal(t, &yes, True.Ptr())
+		assert.Equal(t, &no, False.Ptr())
+		assert.Nil(t, Unset.Ptr())
+	})
+
+	t.Run("OrElse", func(t *testing.T) {
+		assert.True(t, True.OrElse(false))
+		assert.False(t, False.OrElse(true))
+		assert.True(t, Unset.OrElse(true))
+		assert.False(t, Unset.OrElse(false))
+	})
+
+	t.Run("Predicates_and_String", func(t *testing.T) {
+		assert.True(t, True.IsSet())
+		assert.False(t, Unset.IsSet())
+		assert.True(t, True.IsTrue())
+		assert.True(t, False.IsFalse())
+		assert.False(t, Unset.IsFalse())
+		assert.Equal(t, "true", True.String())
+		assert.Equal(t, "false", False.String())
+		assert.Equal(t, "unset", Unset.String())
+	})
+
+	t.Run("Map_and_Filter_interop", func(t *testing.T) {
+		flags := collection.Map([]*bool{&yes, nil, &no, &yes}, FromPtrBool)
+
+		assert.Equal(t, []TriState{True, Unset, False, True}, flags)
+		assert.Len(t, collection.Filter(flags, TriState.IsTrue), 2)
+		assert.Equal(t, []bool{true, true, false, true}, collection.Map(flags, func(flag TriState) bool { return flag.OrElse(true) }))
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		type Payload struct {
+			Active   TriState `json:"active"`
+			Verified TriState `json:"verified"`
+			Deleted  TriState `json:"deleted"`
+		}
+
+		var payload Payload
+		assert.NoError(t, json.Unmarshal([]byte(`{"active":true,"verified":null,"deleted":false}`), &payload))
+		assert.Equal(t, Payload{Active: True, Verified: Unset, Deleted: False}, payload)
+
+		data, err := json.Marshal(payload)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"active":true,"verified":null,"deleted":false}`, string(data))
+
+		assert.Error(t, json.Unmarshal([]byte(`{"active":"yes"}`), &payload))
+	})
+}
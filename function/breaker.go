@@ -0,0 +1,64 @@
+package function
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by a function wrapped with WithCircuitBreaker while the circuit is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// WithCircuitBreaker wraps f so that after threshold consecutive failures the circuit opens and calls
+// fail fast with ErrCircuitOpen, without invoking f, until cooldown has elapsed. The first call after
+// the cooldown is let through as a trial: a success closes the circuit, a failure opens it again.
+// A panic in f counts as a failure and is re-raised. The returned function is safe for concurrent use;
+// concurrent calls during a trial fail fast.
+func WithCircuitBreaker[T any, R any](f func(item T) (R, error), threshold int, cooldown time.Duration) func(item T) (R, error) {
+	if threshold < 1 {
+		threshold = 1
+	}
+	var (
+		mu       sync.Mutex
+		failures int
+		openedAt time.Time
+		trial    bool
+	)
+	record := func(succeeded bool) {
+		mu.Lock()
+		defer mu.Unlock()
+		trial = false
+		if succeeded {
+			failures = 0
+			return
+		}
+		failures++
+		if failures >= threshold {
+			openedAt = time.Now()
+		}
+	}
+
+	return func(item T) (R, error) {
+		mu.Lock()
+		if failures >= threshold {
+			if trial || time.Since(openedAt) < cooldown {
+				mu.Unlock()
+				var zero R
+				return zero, ErrCircuitOpen
+			}
+			trial = true
+		}
+		mu.Unlock()
+
+		panicked := true
+		defer func() {
+			if panicked {
+				record(false)
+			}
+		}()
+		result, err := f(item)
+		panicked = false
+		record(err == nil)
+		return result, err
+	}
+}
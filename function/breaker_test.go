@@ -0,0 +1,105 @@
+package function
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCircuitBreaker(t *testing.T) {
+	errDown := errors.New("service unavailable")
+
+	t.Run("Success_resets_on_success", func(t *testing.T) {
+		calls := 0
+		call := WithCircuitBreaker(func(fail bool) (int, error) {
+			calls++
+			if fail {
+				return 0, errDown
+			}
+			return calls, nil
+		}, 2, time.Hour)
+
+		_, err := call(true)
+		assert.ErrorIs(t, err, errDown)
+		_, err = call(false)
+		assert.NoError(t, err)
+		_, err = call(true)
+		assert.ErrorIs(t, err, errDown)
+
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Error_trips_after_threshold", func(t *testing.T) {
+		calls := 0
+		call := WithCircuitBreaker(func(item int) (int, error) {
+			calls++
+			return 0, errDown
+		}, 3, time.Hour)
+
+		for i := 0; i < 3; i++ {
+			_, err := call(i)
+			assert.ErrorIs(t, err, errDown)
+		}
+		_, err := call(4)
+
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Success_trial_after_cooldown", func(t *testing.T) {
+		healthy := false
+		calls := 0
+		call := WithCircuitBreaker(func(item int) (int, error) {
+			calls++
+			if !healthy {
+				return 0, errDown
+			}
+			return item * 2, nil
+		}, 1, 20*time.Millisecond)
+
+		_, err := call(1)
+		assert.ErrorIs(t, err, errDown)
+		_, err = call(1)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+
+		time.Sleep(30 * time.Millisecond)
+		_, err = call(1)
+		assert.ErrorIs(t, err, errDown)
+		_, err = call(1)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+
+		time.Sleep(30 * time.Millisecond)
+		healthy = true
+		result, err := call(2)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, result)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Success_trial_panic_reopens", func(t *testing.T) {
+		panicking := true
+		call := WithCircuitBreaker(func(item int) (int, error) {
+			if panicking {
+				panic("boom")
+			}
+			return item, nil
+		}, 1, 20*time.Millisecond)
+
+		assert.Panics(t, func() { _, _ = call(1) })
+		_, err := call(1)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+
+		time.Sleep(30 * time.Millisecond)
+		assert.Panics(t, func() { _, _ = call(1) })
+		_, err = call(1)
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+
+		time.Sleep(30 * time.Millisecond)
+		panicking = false
+		result, err := call(2)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+}
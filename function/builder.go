@@ -0,0 +1,17 @@
+package function
+
+// Build returns a copy of base with the options applied in order, leaving base untouched.
+// The copy is shallow: options that mutate slices, maps or pointers shared with base affect base too.
+func Build[T any](base T, opts ...func(*T)) T {
+	Apply(&base, opts...)
+	return base
+}
+
+// Apply runs the mutators against the value in order. Nil mutators are skipped.
+func Apply[T any](value *T, mutators ...func(*T)) {
+	for _, mutate := range mutators {
+		if mutate != nil {
+			mutate(value)
+		}
+	}
+}
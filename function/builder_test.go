@@ -0,0 +1,52 @@
+package function
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type clientConfig struct {
+	BaseURL string
+	Timeout time.Duration
+	Retries int
+}
+
+func withTimeout(timeout time.Duration) func(*clientConfig) {
+	return func(c *clientConfig) { c.Timeout = timeout }
+}
+
+func withRetries(retries int) func(*clientConfig) {
+	return func(c *clientConfig) { c.Retries = retries }
+}
+
+func TestBuild(t *testing.T) {
+	defaults := clientConfig{BaseURL: "https://api.example.com", Timeout: time.Second, Retries: 1}
+
+	t.Run("Success_applies_options_in_order", func(t *testing.T) {
+		config := Build(defaults, withTimeout(5*time.Second), withRetries(3), withRetries(4))
+
+		assert.Equal(t, clientConfig{BaseURL: "https://api.example.com", Timeout: 5 * time.Second, Retries: 4}, config)
+	})
+
+	t.Run("Success_base_is_not_modified", func(t *testing.T) {
+		_ = Build(defaults, withRetries(9))
+
+		assert.Equal(t, 1, defaults.Retries)
+	})
+
+	t.Run("Success_no_options", func(t *testing.T) {
+		assert.Equal(t, defaults, Build(defaults))
+	})
+}
+
+func TestApply(t *testing.T) {
+	t.Run("Success_mutates_in_place_and_skips_nil", func(t *testing.T) {
+		config := clientConfig{}
+
+		Apply(&config, withRetries(2), nil, withTimeout(time.Minute))
+
+		assert.Equal(t, clientConfig{Timeout: time.Minute, Retries: 2}, config)
+	})
+}
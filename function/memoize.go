@@ -0,0 +1,53 @@
+package function
+
+import (
+	"fmt"
+	"sync"
+)
+
+// memoCall is an in-flight or completed call shared by every caller with the same arguments.
+type memoCall[R any] struct {
+	done   chan struct{}
+	result R
+	err    error
+}
+
+// MemoizeStruct wraps f so that results are cached by its comparable argument, typically a struct of
+// parameters. Concurrent calls with identical arguments are coalesced into a single execution of f and
+// share its outcome. Errors are shared with the waiting callers but not cached, so a later call retries.
+// A panic in f is re-raised to its caller, reported as an error to the waiting callers and not cached.
+// The returned function is safe for concurrent use.
+func MemoizeStruct[A comparable, R any](f func(args A) (R, error)) func(args A) (R, error) {
+	var mu sync.Mutex
+	calls := make(map[A]*memoCall[R])
+
+	return func(args A) (R, error) {
+		mu.Lock()
+		if call, exists := calls[args]; exists {
+			mu.Unlock()
+			<-call.done
+			return call.result, call.err
+		}
+		call := &memoCall[R]{done: make(chan struct{})}
+		calls[args] = call
+		mu.Unlock()
+
+		defer func() {
+			recovered := recover()
+			if recovered != nil {
+				call.err = fmt.Errorf("memoize: call panicked: %v", recovered)
+			}
+			if call.err != nil {
+				mu.Lock()
+				delete(calls, args)
+				mu.Unlock()
+			}
+			close(call.done)
+			if recovered != nil {
+				panic(recovered)
+			}
+		}()
+		call.result, call.err = f(args)
+		return call.result, call.err
+	}
+}
@@ -0,0 +1,118 @@
+package function
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoizeStruct(t *testing.T) {
+	type lookupArgs struct {
+		Country string
+		Year    int
+	}
+
+	t.Run("Success_caches_results", func(t *testing.T) {
+		var calls int32
+		lookup := MemoizeStruct(func(args lookupArgs) (string, error) {
+			atomic.AddInt32(&calls, 1)
+			return fmt.Sprintf("%s-%d", args.Country, args.Year), nil
+		})
+
+		first, err := lookup(lookupArgs{Country: "TH", Year: 2024})
+		assert.NoError(t, err)
+		second, err := lookup(lookupArgs{Country: "TH", Year: 2024})
+		assert.NoError(t, err)
+		other, err := lookup(lookupArgs{Country: "JP", Year: 2024})
+		assert.NoError(t, err)
+
+		assert.Equal(t, "TH-2024", first)
+		assert.Equal(t, first, second)
+		assert.Equal(t, "JP-2024", other)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("Success_coalesces_concurrent_calls", func(t *testing.T) {
+		var calls int32
+		release := make(chan struct{})
+		lookup := MemoizeStruct(func(args lookupArgs) (int, error) {
+			atomic.AddInt32(&calls, 1)
+			<-release
+			return args.Year, nil
+		})
+
+		var wg sync.WaitGroup
+		results := make([]int, 10)
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], _ = lookup(lookupArgs{Country: "TH", Year: 2024})
+			}(i)
+		}
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+		for _, result := range results {
+			assert.Equal(t, 2024, result)
+		}
+	})
+
+	t.Run("Error_not_cached", func(t *testing.T) {
+		var calls int32
+		lookup := MemoizeStruct(func(args lookupArgs) (int, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				return 0, errors.New("temporary failure")
+			}
+			return args.Year, nil
+		})
+
+		_, err := lookup(lookupArgs{Year: 2024})
+		assert.EqualError(t, err, "temporary failure")
+
+		result, err := lookup(lookupArgs{Year: 2024})
+		assert.NoError(t, err)
+		assert.Equal(t, 2024, result)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("Error_panic_not_cached", func(t *testing.T) {
+		var calls int32
+		release := make(chan struct{})
+		lookup := MemoizeStruct(func(args lookupArgs) (int, error) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				<-release
+				panic("boom")
+			}
+			return args.Year, nil
+		})
+
+		panicked := make(chan any)
+		go func() {
+			defer func() { panicked <- recover() }()
+			_, _ = lookup(lookupArgs{Year: 2024})
+		}()
+		time.Sleep(20 * time.Millisecond)
+		waiterErr := make(chan error)
+		go func() {
+			_, err := lookup(lookupArgs{Year: 2024})
+			waiterErr <- err
+		}()
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+
+		assert.Equal(t, "boom", <-panicked)
+		assert.EqualError(t, <-waiterErr, "memoize: call panicked: boom")
+
+		result, err := lookup(lookupArgs{Year: 2024})
+		assert.NoError(t, err)
+		assert.Equal(t, 2024, result)
+	})
+}
@@ -0,0 +1,54 @@
+package function
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Jitter returns a random duration in [0, d). A seeded rng makes the value reproducible;
+// a nil rng uses the shared math/rand source.
+func Jitter(d time.Duration, rng *rand.Rand) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	if rng == nil {
+		return time.Duration(rand.Int63n(int64(d)))
+	}
+	return time.Duration(rng.Int63n(int64(d)))
+}
+
+// RetryWithJitter calls f up to attempts times until it succeeds, waiting between attempts with
+// exponential backoff starting at baseDelay and full jitter drawn from rng (see Jitter).
+// It returns nil on success, the context error if ctx ends while waiting, or the last error of f.
+// Attempts below 1 are treated as 1. The backoff stops growing once doubling it would overflow.
+func RetryWithJitter(ctx context.Context, attempts int, baseDelay time.Duration, rng *rand.Rand, f func(ctx context.Context) error) error {
+	attempts = max(attempts, 1)
+	var err error
+	delay := baseDelay
+	for attempt := 0; attempt < attempts; attempt++ {
+		if attempt > 0 {
+			timer := time.NewTimer(Jitter(delay, rng))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+			delay = nextBackoff(delay)
+		}
+		if err = f(ctx); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// nextBackoff doubles the delay, saturating at the largest representable duration.
+func nextBackoff(delay time.Duration) time.Duration {
+	if delay > math.MaxInt64/2 {
+		return math.MaxInt64
+	}
+	return delay * 2
+}
@@ -0,0 +1,92 @@
+package function
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJitter(t *testing.T) {
+	t.Run("Success_reproducible_and_bounded", func(t *testing.T) {
+		first := Jitter(time.Second, rand.New(rand.NewSource(1)))
+		second := Jitter(time.Second, rand.New(rand.NewSource(1)))
+
+		assert.Equal(t, first, second)
+		assert.GreaterOrEqual(t, first, time.Duration(0))
+		assert.Less(t, first, time.Second)
+		assert.Zero(t, Jitter(0, nil))
+	})
+}
+
+func TestRetryWithJitter(t *testing.T) {
+	errFlaky := errors.New("flaky")
+
+	t.Run("Success_after_retries", func(t *testing.T) {
+		calls := 0
+
+		err := RetryWithJitter(context.Background(), 5, time.Millisecond, rand.New(rand.NewSource(3)), func(ctx context.Context) error {
+			calls++
+			if calls < 3 {
+				return errFlaky
+			}
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Error_zero_attempts_still_runs_once", func(t *testing.T) {
+		calls := 0
+
+		err := RetryWithJitter(context.Background(), 0, time.Millisecond, nil, func(ctx context.Context) error {
+			calls++
+			return errFlaky
+		})
+
+		assert.ErrorIs(t, err, errFlaky)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Error_attempts_exhausted", func(t *testing.T) {
+		calls := 0
+
+		err := RetryWithJitter(context.Background(), 3, time.Millisecond, nil, func(ctx context.Context) error {
+			calls++
+			return errFlaky
+		})
+
+		assert.ErrorIs(t, err, errFlaky)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("Error_context_cancelled_while_waiting", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		err := RetryWithJitter(ctx, 3, time.Hour, nil, func(ctx context.Context) error {
+			cancel()
+			return errFlaky
+		})
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestNextBackoff(t *testing.T) {
+	t.Run("Success_doubles_then_saturates", func(t *testing.T) {
+		delay := time.Millisecond
+		for i := 0; i < 100; i++ {
+			next := nextBackoff(delay)
+			assert.GreaterOrEqual(t, next, delay)
+			delay = next
+		}
+
+		assert.Equal(t, 2*time.Second, nextBackoff(time.Second))
+		assert.Equal(t, time.Duration(math.MaxInt64), delay)
+	})
+}
@@ -0,0 +1,35 @@
+package function
+
+import (
+	"context"
+	"time"
+)
+
+// WithTimeout wraps f so that every call gets its own deadline of d, derived from the caller's context.
+// If f does not return in time the wrapped function returns the context error (context.DeadlineExceeded)
+// without waiting for it; f should honour ctx so that abandoned calls stop early.
+func WithTimeout[T any, R any](f func(ctx context.Context, item T) (R, error), d time.Duration) func(ctx context.Context, item T) (R, error) {
+	type outcome struct {
+		result R
+		err    error
+	}
+
+	return func(ctx context.Context, item T) (R, error) {
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+
+		done := make(chan outcome, 1)
+		go func() {
+			result, err := f(ctx, item)
+			done <- outcome{result: result, err: err}
+		}()
+
+		select {
+		case out := <-done:
+			return out.result, out.err
+		case <-ctx.Done():
+			var zero R
+			return zero, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package function
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTimeout(t *testing.T) {
+	slowDouble := func(ctx context.Context, delay time.Duration) (time.Duration, error) {
+		time.Sleep(delay)
+		return delay * 2, nil
+	}
+
+	t.Run("Success_within_deadline", func(t *testing.T) {
+		call := WithTimeout(slowDouble, 100*time.Millisecond)
+
+		result, err := call(context.Background(), time.Millisecond)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2*time.Millisecond, result)
+	})
+
+	t.Run("Error_deadline_exceeded_even_if_ctx_ignored", func(t *testing.T) {
+		call := WithTimeout(slowDouble, 10*time.Millisecond)
+
+		start := time.Now()
+		result, err := call(context.Background(), time.Second)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Zero(t, result)
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+	})
+
+	t.Run("Error_parent_cancelled", func(t *testing.T) {
+		call := WithTimeout(func(ctx context.Context, item int) (int, error) {
+			<-ctx.Done()
+			return 0, ctx.Err()
+		}, time.Hour)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := call(ctx, 1)
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("Error_from_function", func(t *testing.T) {
+		errInvalid := errors.New("invalid")
+		call := WithTimeout(func(ctx context.Context, item int) (int, error) { return 0, errInvalid }, time.Second)
+
+		_, err := call(context.Background(), 1)
+
+		assert.ErrorIs(t, err, errInvalid)
+	})
+}
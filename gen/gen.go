@@ -0,0 +1,150 @@
+// Package gen provides random value generators for property-based tests of mapping, filtering and
+// reducing functions. Generators are plain functions of a *rand.Rand, so a seeded source reproduces a
+// failing case, and QuickConfig plugs them into testing/quick.
+package gen
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing/quick"
+)
+
+// Gen produces a random value of type T from the given source.
+type Gen[T any] func(rng *rand.Rand) T
+
+// Generator is implemented by every Gen and lets generators of different types be combined.
+type Generator interface {
+	Reflect(rng *rand.Rand) reflect.Value
+}
+
+// Reflect generates a value wrapped in a reflect.Value.
+func (g Gen[T]) Reflect(rng *rand.Rand) reflect.Value {
+	return reflect.ValueOf(g(rng))
+}
+
+// Sample generates n values from a source seeded with seed.
+func (g Gen[T]) Sample(seed int64, n int) []T {
+	rng := rand.New(rand.NewSource(seed))
+	result := make([]T, n)
+	for i := range result {
+		result[i] = g(rng)
+	}
+	return result
+}
+
+// GenInt generates integers in [min, max].
+func GenInt(min, max int) Gen[int] {
+	return func(rng *rand.Rand) int {
+		return min + rng.Intn(max-min+1)
+	}
+}
+
+// GenFloat generates floats in [min, max).
+func GenFloat(min, max float64) Gen[float64] {
+	return func(rng *rand.Rand) float64 {
+		return min + rng.Float64()*(max-min)
+	}
+}
+
+// GenBool generates true and false with equal probability.
+func GenBool() Gen[bool] {
+	return func(rng *rand.Rand) bool {
+		return rng.Intn(2) == 1
+	}
+}
+
+const genAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// GenString generates alphanumeric strings with a length in [minLen, maxLen].
+func GenString(minLen, maxLen int) Gen[string] {
+	return func(rng *rand.Rand) string {
+		buffer := make([]byte, minLen+rng.Intn(maxLen-minLen+1))
+		for i := range buffer {
+			buffer[i] = genAlphabet[rng.Intn(len(genAlphabet))]
+		}
+		return string(buffer)
+	}
+}
+
+// GenOneOf picks one of the given values.
+func GenOneOf[T any](values ...T) Gen[T] {
+	return func(rng *rand.Rand) T {
+		return values[rng.Intn(len(values))]
+	}
+}
+
+// GenSlice generates slices with a length in [minLen, maxLen] whose items come from genT.
+func GenSlice[T any](genT Gen[T], minLen, maxLen int) Gen[[]T] {
+	return func(rng *rand.Rand) []T {
+		result := make([]T, minLen+rng.Intn(maxLen-minLen+1))
+		for i := range result {
+			result[i] = genT(rng)
+		}
+		return result
+	}
+}
+
+// GenMap generates maps with up to maxLen entries, and at least minLen when genK can produce enough
+// distinct keys. Keys colliding with earlier ones are retried a bounded number of times.
+func GenMap[K comparable, V any](genK Gen[K], genV Gen[V], minLen, maxLen int) Gen[map[K]V] {
+	return func(rng *rand.Rand) map[K]V {
+		size := minLen + rng.Intn(maxLen-minLen+1)
+		result := make(map[K]V, size)
+		for attempts := 0; len(result) < size && attempts < size*10; attempts++ {
+			result[genK(rng)] = genV(rng)
+		}
+		return result
+	}
+}
+
+// GenStruct generates values of the struct type T. Fields listed in fieldGens are filled by their
+// generator, which must produce the field's type; other exported fields get arbitrary values from
+// testing/quick. It panics on construction if a field does not exist or has a different type.
+func GenStruct[T any](fieldGens map[string]Generator) Gen[T] {
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+	if structType.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("gen: %s is not a struct", structType))
+	}
+	for name := range fieldGens {
+		if _, ok := structType.FieldByName(name); !ok {
+			panic(fmt.Sprintf("gen: field %s does not exist on %s", name, structType))
+		}
+	}
+	return func(rng *rand.Rand) T {
+		var result T
+		value := reflect.ValueOf(&result).Elem()
+		for i := 0; i < structType.NumField(); i++ {
+			field := structType.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if generator, ok := fieldGens[field.Name]; ok {
+				generated := generator.Reflect(rng)
+				if generated.Type() != field.Type {
+					panic(fmt.Sprintf("gen: field %s is of type %s, generator produces %s", field.Name, field.Type, generated.Type()))
+				}
+				value.Field(i).Set(generated)
+				continue
+			}
+			if arbitrary, ok := quick.Value(field.Type, rng); ok {
+				value.Field(i).Set(arbitrary)
+			}
+		}
+		return result
+	}
+}
+
+// QuickConfig returns a testing/quick configuration generating the arguments of the checked function
+// with the given generators, one per argument, from a source seeded with seed.
+func QuickConfig(seed int64, maxCount int, generators ...Generator) *quick.Config {
+	return &quick.Config{
+		MaxCount: maxCount,
+		Rand:     rand.New(rand.NewSource(seed)),
+		Values: func(args []reflect.Value, rng *rand.Rand) {
+			for i := range args {
+				args[i] = generators[i].Reflect(rng)
+			}
+		},
+	}
+}
@@ -0,0 +1,89 @@
+package gen
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
+)
+
+func TestGenerators(t *testing.T) {
+	t.Run("Success_bounds", func(t *testing.T) {
+		for _, n := range GenInt(-3, 3).Sample(1, 200) {
+			assert.GreaterOrEqual(t, n, -3)
+			assert.LessOrEqual(t, n, 3)
+		}
+		for _, s := range GenString(2, 4).Sample(1, 100) {
+			assert.GreaterOrEqual(t, len(s), 2)
+			assert.LessOrEqual(t, len(s), 4)
+		}
+		for _, list := range GenSlice(GenOneOf("a", "b"), 1, 5).Sample(1, 100) {
+			assert.GreaterOrEqual(t, len(list), 1)
+			assert.LessOrEqual(t, len(list), 5)
+			assert.Subset(t, []string{"a", "b"}, list)
+		}
+		for _, m := range GenMap(GenInt(0, 1000), GenBool(), 2, 3).Sample(1, 100) {
+			assert.GreaterOrEqual(t, len(m), 2)
+			assert.LessOrEqual(t, len(m), 3)
+		}
+	})
+
+	t.Run("Success_reproducible", func(t *testing.T) {
+		generator := GenSlice(GenFloat(0, 1), 0, 10)
+
+		assert.Equal(t, generator.Sample(99, 5), generator.Sample(99, 5))
+	})
+
+	t.Run("Success_struct", func(t *testing.T) {
+		type Order struct {
+			ID     string
+			Amount int
+			Paid   bool
+			note   string
+		}
+		generator := GenStruct[Order](map[string]Generator{
+			"ID":     GenString(8, 8),
+			"Amount": GenInt(1, 100),
+		})
+
+		for _, order := range generator.Sample(5, 50) {
+			assert.Len(t, order.ID, 8)
+			assert.GreaterOrEqual(t, order.Amount, 1)
+			assert.LessOrEqual(t, order.Amount, 100)
+			assert.Empty(t, order.note)
+		}
+	})
+
+	t.Run("Error_struct_field_mismatch_panics", func(t *testing.T) {
+		type Order struct {
+			ID string
+		}
+
+		assert.Panics(t, func() { GenStruct[Order](map[string]Generator{"Missing": GenInt(0, 1)}) })
+		assert.Panics(t, func() { GenStruct[Order](map[string]Generator{"ID": GenInt(0, 1)}).Sample(1, 1) })
+	})
+}
+
+func TestQuickConfig(t *testing.T) {
+	t.Run("Success_property_holds", func(t *testing.T) {
+		property := func(list []int) bool {
+			return len(collection.Filter(list, func(n int) bool { return n > 0 })) <= len(list)
+		}
+
+		err := quick.Check(property, QuickConfig(1, 200, GenSlice(GenInt(-50, 50), 0, 20)))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("Error_property_violated", func(t *testing.T) {
+		property := func(list []int) bool {
+			return collection.Sum(list) < 100
+		}
+
+		err := quick.Check(property, QuickConfig(1, 200, GenSlice(GenInt(0, 100), 5, 10)))
+
+		assert.Error(t, err)
+	})
+}
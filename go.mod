@@ -1,6 +1,6 @@
 module github.com/lumiluminousai/golang-fp-utility
 
-go 1.20
+go 1.23
 
 require (
 	github.com/pkg/errors v0.9.1
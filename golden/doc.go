@@ -0,0 +1,9 @@
+// Package golden compares the output of transformations against golden JSON files in tests.
+//
+// The helpers are only compiled with the fptest build tag, so production builds never link the
+// testing package:
+//
+//	go test -tags fptest ./...
+//
+// Set UPDATE_GOLDEN=1 to (re)write the golden files from the current output.
+package golden
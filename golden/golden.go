@@ -0,0 +1,88 @@
+//go:build fptest
+
+package golden
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	reflection "github.com/lumiluminousai/golang-fp-utility/reflection"
+)
+
+// UpdateEnv is the environment variable that, when set to 1, rewrites golden files instead of comparing.
+const UpdateEnv = "UPDATE_GOLDEN"
+
+// AssertTransformedEqual runs the transformation on the input and compares the indented JSON of its
+// output with the golden file, failing the test with both documents on a mismatch. Map keys are sorted
+// by encoding/json, so the comparison is stable.
+func AssertTransformedEqual[I any, O any](t testing.TB, input I, transform func(input I) O, goldenFile string) bool {
+	t.Helper()
+	return AssertJSONEqual(t, transform(input), goldenFile)
+}
+
+// AssertTransformedEqualWithError is AssertTransformedEqual for fallible transformations;
+// an error fails the test.
+func AssertTransformedEqualWithError[I any, O any](t testing.TB, input I, transform func(input I) (O, error), goldenFile string) bool {
+	t.Helper()
+	output, err := transform(input)
+	if err != nil {
+		t.Errorf("golden: transformation failed: %v", err)
+		return false
+	}
+	return AssertJSONEqual(t, output, goldenFile)
+}
+
+// AssertJSONEqual compares the indented JSON of the value with the golden file.
+func AssertJSONEqual(t testing.TB, value any, goldenFile string) bool {
+	t.Helper()
+	actual, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		t.Errorf("golden: cannot encode output: %v", err)
+		return false
+	}
+	actual = append(actual, '\n')
+
+	if os.Getenv(UpdateEnv) == "1" {
+		if err := os.MkdirAll(filepath.Dir(goldenFile), 0o755); err != nil {
+			t.Errorf("golden: cannot create directory for %s: %v", goldenFile, err)
+			return false
+		}
+		if err := os.WriteFile(goldenFile, actual, 0o644); err != nil {
+			t.Errorf("golden: cannot write %s: %v", goldenFile, err)
+			return false
+		}
+		return true
+	}
+
+	expected, err := os.ReadFile(goldenFile)
+	if err != nil {
+		t.Errorf("golden: cannot read %s (run with %s=1 to create it): %v", goldenFile, UpdateEnv, err)
+		return false
+	}
+	if !jsonEqual(expected, actual) {
+		t.Errorf("golden: output differs from %s (- golden, + actual):\n%s", goldenFile, jsonDiff(expected, actual))
+		return false
+	}
+	return true
+}
+
+// jsonEqual compares two JSON documents ignoring formatting.
+func jsonEqual(expected, actual []byte) bool {
+	var expectedBuffer, actualBuffer bytes.Buffer
+	if json.Compact(&expectedBuffer, expected) != nil || json.Compact(&actualBuffer, actual) != nil {
+		return bytes.Equal(expected, actual)
+	}
+	return bytes.Equal(expectedBuffer.Bytes(), actualBuffer.Bytes())
+}
+
+// jsonDiff renders a line diff of two JSON documents with sorted object keys.
+func jsonDiff(expected, actual []byte) string {
+	var expectedValue, actualValue any
+	if json.Unmarshal(expected, &expectedValue) != nil || json.Unmarshal(actual, &actualValue) != nil {
+		return reflection.DiffString(string(expected), string(actual))
+	}
+	return reflection.DiffString(expectedValue, actualValue)
+}
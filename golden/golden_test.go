@@ -0,0 +1,70 @@
+//go:build fptest
+
+package golden
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
+)
+
+// recordingT captures failures instead of failing the surrounding test.
+type recordingT struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recordingT) Helper() {}
+
+func (r *recordingT) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func TestAssertTransformedEqual(t *testing.T) {
+	type Order struct {
+		ID     string `json:"id"`
+		Amount int    `json:"amount"`
+	}
+	orders := []Order{{"o1", 10}, {"o2", 25}}
+	double := func(orders []Order) []Order {
+		return collection.Map(orders, func(o Order) Order { return Order{ID: o.ID, Amount: o.Amount * 2} })
+	}
+
+	t.Run("Success_matches_golden", func(t *testing.T) {
+		AssertTransformedEqual(t, orders, double, filepath.Join("testdata", "doubled_orders.json"))
+	})
+
+	t.Run("Error_mismatch", func(t *testing.T) {
+		recorder := &recordingT{TB: t}
+
+		ok := AssertTransformedEqual(recorder, orders, func(orders []Order) []Order { return orders }, filepath.Join("testdata", "doubled_orders.json"))
+
+		assert.False(t, ok)
+		assert.Len(t, recorder.failures, 1)
+		assert.Contains(t, recorder.failures[0], "-     \"amount\": 20,\n+     \"amount\": 10,")
+	})
+
+	t.Run("Success_update_writes_file", func(t *testing.T) {
+		t.Setenv(UpdateEnv, "1")
+		goldenFile := filepath.Join(t.TempDir(), "nested", "out.json")
+
+		assert.True(t, AssertTransformedEqual(t, orders, double, goldenFile))
+		t.Setenv(UpdateEnv, "0")
+		assert.True(t, AssertTransformedEqual(t, orders, double, goldenFile))
+	})
+
+	t.Run("Error_missing_file_and_transform_error", func(t *testing.T) {
+		recorder := &recordingT{TB: t}
+
+		assert.False(t, AssertTransformedEqual(recorder, orders, double, filepath.Join("testdata", "missing.json")))
+		assert.False(t, AssertTransformedEqualWithError(recorder, orders, func(orders []Order) ([]Order, error) {
+			return nil, errors.New("boom")
+		}, filepath.Join("testdata", "doubled_orders.json")))
+		assert.Len(t, recorder.failures, 2)
+	})
+}
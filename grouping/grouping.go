@@ -3,51 +3,326 @@ package grouping
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
+	hashing "github.com/lumiluminousai/golang-fp-utility/hashing"
+	maps "github.com/lumiluminousai/golang-fp-utility/maps"
 	reflection "github.com/lumiluminousai/golang-fp-utility/reflection"
 )
 
+// fieldKey reads the grouping key of an element, converting reflection panics into errors
+// so that a mistyped field path cannot crash the caller. Keys that cannot index a map, such as
+// slices behind an interface key type, are rejected as well.
+func fieldKey[K comparable](element reflect.Value, fieldName string) (key K, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("groupBy: field %s could not be read: %v", fieldName, r)
+		}
+	}()
+	fieldValue := reflection.GetField(element, fieldName)
+	if !fieldValue.IsValid() {
+		return key, fmt.Errorf("groupBy: field %s does not exist", fieldName)
+	}
+	if !fieldValue.CanInterface() {
+		return key, fmt.Errorf("groupBy: field %s is not exported", fieldName)
+	}
+	key, ok := fieldValue.Interface().(K)
+	if !ok {
+		return key, fmt.Errorf("groupBy: field %s is of type %s", fieldName, fieldValue.Type())
+	}
+	if dynamic := reflect.ValueOf(key); dynamic.IsValid() && !dynamic.Comparable() {
+		return key, fmt.Errorf("groupBy: field %s holds non-comparable type %s", fieldName, dynamic.Type())
+	}
+	return key, nil
+}
+
 // GroupBy groups elements of a list by a specified field name.
-func GroupBy[K comparable, V any](slice []V, fieldName string) (map[K][]V, error) {
+// Options such as collection.WithLimit restrict the elements that are grouped.
+func GroupBy[K comparable, V any](slice []V, fieldName string, opts ...collection.SliceOption) (map[K][]V, error) {
+	return GroupByNormalized[K](slice, fieldName, nil, opts...)
+}
+
+// GroupByNormalized groups elements of a list by a specified field name after passing each key through
+// normalize, so that keys differing only in representation land in the same group. See NormalizeTime,
+// TruncateTime and RoundFloat. A nil normalize groups by the raw key.
+func GroupByNormalized[K comparable, V any](slice []V, fieldName string, normalize func(key K) K, opts ...collection.SliceOption) (map[K][]V, error) {
 	result := make(map[K][]V)
 	sliceValue := reflect.ValueOf(slice)
 	if sliceValue.Kind() != reflect.Slice {
 		return nil, fmt.Errorf("groupBy: provided argument is not a slice")
 	}
-	for i := 0; i < sliceValue.Len(); i++ {
+	start, end := collection.ResolveSliceOptions(sliceValue.Len(), opts...)
+	for i := start; i < end; i++ {
 		element := sliceValue.Index(i)
-		fieldValue := reflection.GetField(element, fieldName)
-		if !fieldValue.IsValid() {
-			return nil, fmt.Errorf("groupBy: field %s does not exist", fieldName)
+		key, err := fieldKey[K](element, fieldName)
+		if err != nil {
+			return nil, err
+		}
+		if normalize != nil {
+			key = normalize(key)
 		}
-		key := fieldValue.Interface().(K)
 		result[key] = append(result[key], element.Interface().(V))
 	}
 	return result, nil
 }
 
+// GroupByFunc groups elements of a list by the key computed by keyFunc, without reflection,
+// so keys may be computed or read from unexported fields. Elements keep their list order within a group.
+func GroupByFunc[K comparable, V any](slice []V, keyFunc func(item V) K) map[K][]V {
+	result := make(map[K][]V)
+	for _, item := range slice {
+		key := keyFunc(item)
+		result[key] = append(result[key], item)
+	}
+	return result
+}
+
+// GroupByTransform groups elements of a list by the key computed by keyFunc, storing the transformed
+// elements instead of the elements themselves. Transformed elements keep their list order within a group.
+func GroupByTransform[K comparable, V any, R any](slice []V, keyFunc func(item V) K, transform func(item V) R) map[K][]R {
+	result := make(map[K][]R)
+	for _, item := range slice {
+		key := keyFunc(item)
+		result[key] = append(result[key], transform(item))
+	}
+	return result
+}
+
+// GroupByAggregate folds the elements of each group into an accumulator in a single pass, without
+// materializing the groups. Every group starts from seed; elements are folded in list order.
+func GroupByAggregate[K comparable, V any, A any](slice []V, keyFunc func(item V) K, seed A, fold func(acc A, item V) A) map[K]A {
+	result := make(map[K]A)
+	for _, item := range slice {
+		key := keyFunc(item)
+		acc, exists := result[key]
+		if !exists {
+			acc = seed
+		}
+		result[key] = fold(acc, item)
+	}
+	return result
+}
+
+// ReduceGroups reduces the items of each group of a grouped result to a single value, starting every
+// group from initialValue. Groups without items map to initialValue.
+func ReduceGroups[K comparable, V any, A any](groups map[K][]V, reduceFunc func(acc A, item V) A, initialValue A) map[K]A {
+	result := make(map[K]A, len(groups))
+	for key, items := range groups {
+		acc := initialValue
+		for _, item := range items {
+			acc = reduceFunc(acc, item)
+		}
+		result[key] = acc
+	}
+	return result
+}
+
+// GroupBySorted groups elements of a list by a specified field name and sorts the items of each group
+// with the less function. Items comparing equal keep their order from the list.
+func GroupBySorted[K comparable, V any](slice []V, fieldName string, less func(a, b V) bool, opts ...collection.SliceOption) (map[K][]V, error) {
+	result, err := GroupBy[K](slice, fieldName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for _, items := range result {
+		sort.SliceStable(items, func(i, j int) bool { return less(items[i], items[j]) })
+	}
+	return result, nil
+}
+
+// DuplicateKey describes a key shared by more than one element and the indices of those elements.
+type DuplicateKey struct {
+	Key     any
+	Indices []int
+}
+
+// DuplicateKeyError is returned when grouping one-by-one finds elements sharing the same key.
+// FieldName is empty when the keys were computed by a function.
+type DuplicateKeyError struct {
+	FieldName  string
+	Duplicates []DuplicateKey
+}
+
+// Error renders the duplicated keys together with the indices of the conflicting elements.
+func (e *DuplicateKeyError) Error() string {
+	details := make([]string, 0, len(e.Duplicates))
+	for _, duplicate := range e.Duplicates {
+		details = append(details, fmt.Sprintf("'%v' at indices %v", duplicate.Key, duplicate.Indices))
+	}
+	if e.FieldName == "" {
+		return fmt.Sprintf("groupBy: keys are not unique, duplicate keys: %s", strings.Join(details, ", "))
+	}
+	return fmt.Sprintf("groupBy: field %s is not unique, duplicate keys: %s", e.FieldName, strings.Join(details, ", "))
+}
+
+// DuplicatePolicy controls how one-by-one grouping handles elements sharing the same key.
+type DuplicatePolicy int
+
+const (
+	// ErrorOnDuplicate fails with a *DuplicateKeyError when keys are duplicated.
+	ErrorOnDuplicate DuplicatePolicy = iota
+	// KeepFirst keeps the first element seen for each key.
+	KeepFirst
+	// KeepLast keeps the last element seen for each key.
+	KeepLast
+)
+
 // GroupBy1By1 groups elements of a list by a specified field name, ensuring uniqueness.
-func GroupBy1By1[K comparable, V any](slice []V, fieldName string) (map[K]V, error) {
-	grouped := make(map[K][]V)
+// When keys are duplicated the returned error is a *DuplicateKeyError.
+func GroupBy1By1[K comparable, V any](slice []V, fieldName string, opts ...collection.SliceOption) (map[K]V, error) {
+	return GroupBy1By1WithPolicy[K](slice, fieldName, ErrorOnDuplicate, opts...)
+}
+
+// GroupBy1By1WithPolicy groups elements of a list by a specified field name, one element per key,
+// resolving duplicated keys according to the given policy. Duplicate indices refer to the full list.
+func GroupBy1By1WithPolicy[K comparable, V any](slice []V, fieldName string, policy DuplicatePolicy, opts ...collection.SliceOption) (map[K]V, error) {
 	sliceValue := reflect.ValueOf(slice)
 	if sliceValue.Kind() != reflect.Slice {
 		return nil, fmt.Errorf("groupBy: provided argument is not a slice")
 	}
-	for i := 0; i < sliceValue.Len(); i++ {
-		element := sliceValue.Index(i)
-		fieldValue := reflection.GetField(element, fieldName)
-		if !fieldValue.IsValid() {
-			return nil, fmt.Errorf("groupBy: field %s does not exist", fieldName)
+	start, end := collection.ResolveSliceOptions(sliceValue.Len(), opts...)
+	keyAt := func(i int) (K, error) { return fieldKey[K](sliceValue.Index(i), fieldName) }
+	return groupOneByOne(slice, start, end, keyAt, policy, fieldName)
+}
+
+// GroupBy1By1Func groups elements of a list by the key computed by keyFunc, ensuring uniqueness.
+// When keys are duplicated the returned error is a *DuplicateKeyError.
+func GroupBy1By1Func[K comparable, V any](slice []V, keyFunc func(item V) K) (map[K]V, error) {
+	return GroupBy1By1FuncWithPolicy(slice, keyFunc, ErrorOnDuplicate)
+}
+
+// GroupBy1By1FuncWithPolicy groups elements of a list by the key computed by keyFunc, one element
+// per key, resolving duplicated keys according to the given policy.
+func GroupBy1By1FuncWithPolicy[K comparable, V any](slice []V, keyFunc func(item V) K, policy DuplicatePolicy) (map[K]V, error) {
+	keyAt := func(i int) (K, error) { return keyFunc(slice[i]), nil }
+	return groupOneByOne(slice, 0, len(slice), keyAt, policy, "")
+}
+
+// groupOneByOne keeps one element per key for the elements in [start, end), reporting duplicated
+// keys with their indices when the policy asks for it.
+func groupOneByOne[K comparable, V any](slice []V, start, end int, keyAt func(i int) (K, error), policy DuplicatePolicy, fieldName string) (map[K]V, error) {
+	uniqueResult := make(map[K]V)
+	indices := make(map[K][]int)
+	keyOrder := []K{}
+	for i := start; i < end; i++ {
+		key, err := keyAt(i)
+		if err != nil {
+			return nil, err
 		}
-		key := fieldValue.Interface().(K)
-		grouped[key] = append(grouped[key], element.Interface().(V))
+		if _, exists := indices[key]; !exists {
+			keyOrder = append(keyOrder, key)
+			uniqueResult[key] = slice[i]
+		} else if policy == KeepLast {
+			uniqueResult[key] = slice[i]
+		}
+		indices[key] = append(indices[key], i)
 	}
-	uniqueResult := make(map[K]V)
-	for key, value := range grouped {
-		if len(value) > 1 {
-			return nil, fmt.Errorf("groupBy: field %s is not unique", fieldName)
+	if policy != ErrorOnDuplicate {
+		return uniqueResult, nil
+	}
+	duplicates := []DuplicateKey{}
+	for _, key := range keyOrder {
+		if len(indices[key]) > 1 {
+			duplicates = append(duplicates, DuplicateKey{Key: key, Indices: indices[key]})
 		}
-		uniqueResult[key] = value[0]
+	}
+	if len(duplicates) > 0 {
+		return nil, &DuplicateKeyError{FieldName: fieldName, Duplicates: duplicates}
 	}
 	return uniqueResult, nil
 }
+
+// IndexOfGroups maps each element of the grouped result back to the key of the group it belongs to.
+// Elements are identified by keyOf; if an identifier appears in several groups, any one of those keys is kept.
+func IndexOfGroups[K comparable, V any, ID comparable](groups map[K][]V, keyOf func(item V) ID) map[ID]K {
+	result := make(map[ID]K)
+	for key, items := range groups {
+		for _, item := range items {
+			result[keyOf(item)] = key
+		}
+	}
+	return result
+}
+
+// GroupCount counts the elements of a list per key without materializing the groups.
+func GroupCount[K comparable, V any](slice []V, keyFunc func(item V) K) map[K]int {
+	result := make(map[K]int)
+	for _, item := range slice {
+		result[keyFunc(item)]++
+	}
+	return result
+}
+
+// GroupCountByField counts the elements of a list per value of a specified field name.
+func GroupCountByField[K comparable, V any](slice []V, fieldName string, opts ...collection.SliceOption) (map[K]int, error) {
+	result := make(map[K]int)
+	start, end := collection.ResolveSliceOptions(len(slice), opts...)
+	for i := start; i < end; i++ {
+		key, err := fieldKey[K](reflect.ValueOf(slice[i]), fieldName)
+		if err != nil {
+			return nil, err
+		}
+		result[key]++
+	}
+	return result, nil
+}
+
+// Group is a key together with the elements grouped under it.
+type Group[K comparable, V any] struct {
+	Key   K   `json:"key"`
+	Items []V `json:"items"`
+}
+
+// SortedGroups turns a grouped result into groups ordered by keyLess, with the items of each group
+// sorted by itemLess, ready for rendering. Items comparing equal keep their order; a nil itemLess keeps
+// the items as they are. The input map and its slices are not modified.
+func SortedGroups[K comparable, V any](groups map[K][]V, keyLess func(a, b K) bool, itemLess func(a, b V) bool) []Group[K, V] {
+	result := make([]Group[K, V], 0, len(groups))
+	for _, key := range maps.SortedKeysBy(groups, keyLess) {
+		items := append([]V{}, groups[key]...)
+		if itemLess != nil {
+			sort.SliceStable(items, func(i, j int) bool { return itemLess(items[i], items[j]) })
+		}
+		result = append(result, Group[K, V]{Key: key, Items: items})
+	}
+	return result
+}
+
+// GroupAdjacentBy groups consecutive elements sharing the same key, preserving the order of the list.
+// Unlike GroupBy, a key may appear in several groups if its elements are not adjacent.
+func GroupAdjacentBy[K comparable, V any](slice []V, keyFunc func(item V) K) []Group[K, V] {
+	result := []Group[K, V]{}
+	for _, item := range slice {
+		key := keyFunc(item)
+		if last := len(result) - 1; last >= 0 && result[last].Key == key {
+			result[last].Items = append(result[last].Items, item)
+			continue
+		}
+		result = append(result, Group[K, V]{Key: key, Items: []V{item}})
+	}
+	return result
+}
+
+// ShardBy partitions a list into the given number of shards by hashing each element's key with a stable
+// hash, so elements sharing a key always land in the same shard, across runs and processes.
+// Elements keep their list order within a shard.
+func ShardBy[K comparable, V any](slice []V, keyFunc func(item V) K, shards int) ([][]V, error) {
+	if shards < 1 {
+		return nil, fmt.Errorf("shardBy: shard count %d must be positive", shards)
+	}
+	result := make([][]V, shards)
+	for i := range result {
+		result[i] = []V{}
+	}
+	for _, item := range slice {
+		hash, err := hashing.HashComparable(keyFunc(item))
+		if err != nil {
+			return nil, fmt.Errorf("shardBy: %w", err)
+		}
+		shard := hash % uint64(shards)
+		result[shard] = append(result[shard], item)
+	}
+	return result, nil
+}
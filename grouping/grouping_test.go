@@ -1,9 +1,12 @@
 package grouping
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
 )
 
 func TestGroupBy(t *testing.T) {
@@ -253,7 +256,7 @@ func TestGroupBy1by1(t *testing.T) {
 
 		_, err := GroupBy1By1[int](people, fieldName)
 		assert.Error(t, err)
-		assert.Equal(t, "groupBy: field Age is not unique", err.Error())
+		assert.Equal(t, "groupBy: field Age is not unique, duplicate keys: '30' at indices [0 1]", err.Error())
 	})
 
 	t.Run("Success_groupBy_name", func(t *testing.T) {
@@ -351,7 +354,7 @@ func TestGroupBy1by1(t *testing.T) {
 
 		_, err := GroupBy1By1[string](people, fieldName)
 		assert.Error(t, err)
-		assert.Equal(t, "groupBy: field Name is not unique", err.Error())
+		assert.Equal(t, "groupBy: field Name is not unique, duplicate keys: 'Charlie' at indices [2 3]", err.Error())
 	})
 
 	t.Run("Error_key_dupe_groupBy_layer2_field", func(t *testing.T) {
@@ -405,7 +408,7 @@ func TestGroupBy1by1(t *testing.T) {
 
 		_, err := GroupBy1By1[string](people, fieldName)
 		assert.Error(t, err)
-		assert.Equal(t, "groupBy: field Layer2.Field1 is not unique", err.Error())
+		assert.Equal(t, "groupBy: field Layer2.Field1 is not unique, duplicate keys: 'Value1' at indices [0 3]", err.Error())
 	})
 
 	t.Run("Success_groupBy_layer3_field", func(t *testing.T) {
@@ -566,7 +569,7 @@ func TestGroupBy1by1(t *testing.T) {
 
 		_, err := GroupBy1By1[string](people, fieldName)
 		assert.Error(t, err)
-		assert.Equal(t, "groupBy: field Layer2.Layer3.Field3 is not unique", err.Error())
+		assert.Equal(t, "groupBy: field Layer2.Layer3.Field3 is not unique, duplicate keys: 'layer3-2' at indices [1 3]", err.Error())
 	})
 
 	t.Run("Error_invalid_field_name", func(t *testing.T) {
@@ -585,4 +588,514 @@ func TestGroupBy1by1(t *testing.T) {
 		assert.Nil(t, result)
 	})
 
+	t.Run("Error_duplicate_key_error_details", func(t *testing.T) {
+		people := []Person{
+			{Name: "Alice", Age: 30},
+			{Name: "Bob", Age: 25},
+			{Name: "Charlie", Age: 30},
+			{Name: "Dave", Age: 25},
+			{Name: "Eve", Age: 30},
+		}
+
+		result, err := GroupBy1By1[int](people, "Age")
+		assert.Nil(t, result)
+
+		var duplicateErr *DuplicateKeyError
+		assert.True(t, errors.As(err, &duplicateErr))
+		assert.Equal(t, "Age", duplicateErr.FieldName)
+		assert.Equal(t, []DuplicateKey{
+			{Key: 30, Indices: []int{0, 2, 4}},
+			{Key: 25, Indices: []int{1, 3}},
+		}, duplicateErr.Duplicates)
+		assert.Equal(t, "groupBy: field Age is not unique, duplicate keys: '30' at indices [0 2 4], '25' at indices [1 3]", err.Error())
+	})
+}
+
+func TestGroupBy1By1WithPolicy(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	people := []Person{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Charlie", Age: 30},
+	}
+
+	t.Run("Success_keep_first", func(t *testing.T) {
+		result, err := GroupBy1By1WithPolicy[int](people, "Age", KeepFirst)
+		assert.NoError(t, err)
+
+		expected := map[int]Person{
+			30: people[0],
+			25: people[1],
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Success_keep_last", func(t *testing.T) {
+		result, err := GroupBy1By1WithPolicy[int](people, "Age", KeepLast)
+		assert.NoError(t, err)
+
+		expected := map[int]Person{
+			30: people[2],
+			25: people[1],
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Error_on_duplicate", func(t *testing.T) {
+		result, err := GroupBy1By1WithPolicy[int](people, "Age", ErrorOnDuplicate)
+		assert.Nil(t, result)
+		assert.Equal(t, "groupBy: field Age is not unique, duplicate keys: '30' at indices [0 2]", err.Error())
+	})
+
+	t.Run("Error_invalid_field_name", func(t *testing.T) {
+		result, err := GroupBy1By1WithPolicy[int](people, "Nonexistent", KeepFirst)
+		assert.Nil(t, result)
+		assert.Equal(t, "groupBy: field Nonexistent does not exist", err.Error())
+	})
+}
+
+func TestIndexOfGroups(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		people := []Person{
+			{Name: "Alice", Age: 30},
+			{Name: "Bob", Age: 30},
+			{Name: "Charlie", Age: 25},
+		}
+
+		groups, err := GroupBy[int](people, "Age")
+		assert.NoError(t, err)
+
+		result := IndexOfGroups(groups, func(p Person) string { return p.Name })
+
+		expected := map[string]int{
+			"Alice":   30,
+			"Bob":     30,
+			"Charlie": 25,
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Success_empty_groups", func(t *testing.T) {
+		result := IndexOfGroups(map[int][]Person{}, func(p Person) string { return p.Name })
+
+		assert.Equal(t, map[string]int{}, result)
+	})
+}
+
+func TestGroupCount(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+
+	people := []Person{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 30},
+		{Name: "Charlie", Age: 25},
+	}
+
+	t.Run("Success_key_func", func(t *testing.T) {
+		result := GroupCount(people, func(p Person) int { return p.Age })
+
+		assert.Equal(t, map[int]int{30: 2, 25: 1}, result)
+	})
+
+	t.Run("Success_empty_list", func(t *testing.T) {
+		result := GroupCount([]Person{}, func(p Person) int { return p.Age })
+
+		assert.Equal(t, map[int]int{}, result)
+	})
+
+	t.Run("Success_field_name", func(t *testing.T) {
+		result, err := GroupCountByField[int](people, "Age")
+		assert.NoError(t, err)
+
+		assert.Equal(t, map[int]int{30: 2, 25: 1}, result)
+	})
+
+	t.Run("Error_invalid_field_name", func(t *testing.T) {
+		result, err := GroupCountByField[int](people, "Nonexistent")
+
+		assert.Nil(t, result)
+		assert.Equal(t, "groupBy: field Nonexistent does not exist", err.Error())
+	})
+}
+
+func TestGroupAdjacentBy(t *testing.T) {
+	t.Run("Success_runs", func(t *testing.T) {
+		source := []int{1, 1, 2, 2, 2, 1, 3}
+
+		result := GroupAdjacentBy(source, func(item int) int { return item })
+
+		expected := []Group[int, int]{
+			{Key: 1, Items: []int{1, 1}},
+			{Key: 2, Items: []int{2, 2, 2}},
+			{Key: 1, Items: []int{1}},
+			{Key: 3, Items: []int{3}},
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Success_computed_key", func(t *testing.T) {
+		source := []string{"apple", "avocado", "banana", "blueberry", "apricot"}
+
+		result := GroupAdjacentBy(source, func(item string) byte { return item[0] })
+
+		expected := []Group[byte, string]{
+			{Key: 'a', Items: []string{"apple", "avocado"}},
+			{Key: 'b', Items: []string{"banana", "blueberry"}},
+			{Key: 'a', Items: []string{"apricot"}},
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Success_empty_list", func(t *testing.T) {
+		result := GroupAdjacentBy([]int{}, func(item int) int { return item })
+
+		assert.Equal(t, []Group[int, int]{}, result)
+	})
+}
+
+func TestGroupBySorted(t *testing.T) {
+	type Order struct {
+		Customer string
+		Number   string
+		Amount   int
+	}
+	orders := []Order{
+		{Customer: "C2", Number: "S2", Amount: 200},
+		{Customer: "C1", Number: "S3", Amount: 300},
+		{Customer: "C2", Number: "S4", Amount: 100},
+		{Customer: "C1", Number: "S1", Amount: 300},
+	}
+
+	t.Run("Success_sort_each_group", func(t *testing.T) {
+		result, err := GroupBySorted[string](orders, "Customer", func(a, b Order) bool { return a.Number < b.Number })
+		assert.NoError(t, err)
+
+		expected := map[string][]Order{
+			"C1": {orders[3], orders[1]},
+			"C2": {orders[0], orders[2]},
+		}
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("Success_stable_for_equal_items", func(t *testing.T) {
+		result, err := GroupBySorted[string](orders, "Customer", func(a, b Order) bool { return a.Amount > b.Amount })
+		assert.NoError(t, err)
+
+		assert.Equal(t, []Order{orders[1], orders[3]}, result["C1"])
+		assert.Equal(t, []Order{orders[0], orders[2]}, result["C2"])
+	})
+
+	t.Run("Error_invalid_field_name", func(t *testing.T) {
+		result, err := GroupBySorted[string](orders, "Nonexistent", func(a, b Order) bool { return false })
+
+		assert.Nil(t, result)
+		assert.Equal(t, "groupBy: field Nonexistent does not exist", err.Error())
+	})
+}
+
+func TestShardBy(t *testing.T) {
+	type Event struct {
+		Account string
+		Seq     int
+	}
+	events := []Event{}
+	for seq := 0; seq < 60; seq++ {
+		events = append(events, Event{Account: []string{"A", "B", "C", "D", "E", "F"}[seq%6], Seq: seq})
+	}
+	accountOf := func(e Event) string { return e.Account }
+
+	t.Run("Success_keys_not_split_across_shards", func(t *testing.T) {
+		shards, err := ShardBy(events, accountOf, 4)
+		assert.NoError(t, err)
+		assert.Len(t, shards, 4)
+
+		shardOfAccount := map[string]int{}
+		total := 0
+		for shardIdx, shard := range shards {
+			total += len(shard)
+			for i, event := range shard {
+				if previous, exists := shardOfAccount[event.Account]; exists {
+					assert.Equal(t, previous, shardIdx)
+				}
+				shardOfAccount[event.Account] = shardIdx
+				if i > 0 {
+					assert.Less(t, shard[i-1].Seq, event.Seq)
+				}
+			}
+		}
+		assert.Equal(t, len(events), total)
+	})
+
+	t.Run("Success_stable_across_calls", func(t *testing.T) {
+		first, err := ShardBy(events, accountOf, 3)
+		assert.NoError(t, err)
+		second, err := ShardBy(events, accountOf, 3)
+		assert.NoError(t, err)
+
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("Success_empty_list", func(t *testing.T) {
+		shards, err := ShardBy([]Event{}, accountOf, 2)
+		assert.NoError(t, err)
+
+		assert.Equal(t, [][]Event{{}, {}}, shards)
+	})
+
+	t.Run("Error_invalid_shard_count", func(t *testing.T) {
+		shards, err := ShardBy(events, accountOf, 0)
+
+		assert.Nil(t, shards)
+		assert.Equal(t, "shardBy: shard count 0 must be positive", err.Error())
+	})
+}
+
+func TestGroupByReflectionErrors(t *testing.T) {
+	type Person struct {
+		Name   string
+		Age    int
+		secret string
+		Boss   *Person
+	}
+	people := []Person{{Name: "Alice", Age: 30, secret: "x"}, {Name: "Bob", Age: 25, secret: "y"}}
+
+	t.Run("Error_key_type_mismatch", func(t *testing.T) {
+		result, err := GroupBy[string](people, "Age")
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "groupBy: field Age is of type int")
+	})
+
+	t.Run("Error_unexported_field", func(t *testing.T) {
+		result, err := GroupBy1By1[string](people, "secret")
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "groupBy: field secret is not exported")
+	})
+
+	t.Run("Error_path_through_non_struct", func(t *testing.T) {
+		result, err := GroupCountByField[string](people, "Name.First")
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "groupBy: field Name.First does not exist")
+	})
+
+	t.Run("Error_nil_pointer_in_path", func(t *testing.T) {
+		result, err := GroupBySorted[string](people, "Boss.Name", func(a, b Person) bool { return false })
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "groupBy: field Boss.Name does not exist")
+	})
+
+	t.Run("Error_non_comparable_key", func(t *testing.T) {
+		type Tagged struct {
+			Tags   []string
+			Labels map[string]string
+		}
+		tagged := []Tagged{{Tags: []string{"a"}, Labels: map[string]string{"k": "v"}}}
+
+		groups, err := GroupBy[any](tagged, "Tags")
+		assert.Nil(t, groups)
+		assert.EqualError(t, err, "groupBy: field Tags holds non-comparable type []string")
+
+		unique, err := GroupBy1By1[any](tagged, "Labels")
+		assert.Nil(t, unique)
+		assert.EqualError(t, err, "groupBy: field Labels holds non-comparable type map[string]string")
+
+		counts, err := GroupCountByField[any](tagged, "Tags")
+		assert.Nil(t, counts)
+		assert.EqualError(t, err, "groupBy: field Tags holds non-comparable type []string")
+	})
+}
+
+func TestGroupByWithSliceOptions(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+	people := []Person{
+		{Name: "Alice", Age: 30},
+		{Name: "Bob", Age: 25},
+		{Name: "Carol", Age: 30},
+		{Name: "Dave", Age: 25},
+		{Name: "Eve", Age: 40},
+	}
+
+	t.Run("Success_groupBy_preview", func(t *testing.T) {
+		result, err := GroupBy[int](people, "Age", collection.WithLimit(3))
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[int][]Person{
+			30: {{Name: "Alice", Age: 30}, {Name: "Carol", Age: 30}},
+			25: {{Name: "Bob", Age: 25}},
+		}, result)
+	})
+
+	t.Run("Success_groupCountByField_offset", func(t *testing.T) {
+		result, err := GroupCountByField[int](people, "Age", collection.WithOffset(3))
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]int{25: 1, 40: 1}, result)
+	})
+
+	t.Run("Error_duplicate_indices_refer_to_full_list", func(t *testing.T) {
+		_, err := GroupBy1By1[int](people, "Age", collection.WithOffset(1), collection.WithLimit(3))
+
+		var duplicateErr *DuplicateKeyError
+		assert.True(t, errors.As(err, &duplicateErr))
+		assert.Equal(t, []DuplicateKey{{Key: 25, Indices: []int{1, 3}}}, duplicateErr.Duplicates)
+	})
+}
+
+func TestGroupByFunc(t *testing.T) {
+	type account struct {
+		id      string
+		balance int
+	}
+	accounts := []account{{"a1", -5}, {"a2", 10}, {"a3", 0}, {"a4", -1}}
+	sign := func(a account) string {
+		switch {
+		case a.balance < 0:
+			return "negative"
+		case a.balance > 0:
+			return "positive"
+		}
+		return "zero"
+	}
+
+	t.Run("Success_groupByFunc_unexported_computed_key", func(t *testing.T) {
+		result := GroupByFunc(accounts, sign)
+
+		assert.Equal(t, map[string][]account{
+			"negative": {{"a1", -5}, {"a4", -1}},
+			"positive": {{"a2", 10}},
+			"zero":     {{"a3", 0}},
+		}, result)
+	})
+
+	t.Run("Success_groupBy1By1Func", func(t *testing.T) {
+		result, err := GroupBy1By1Func(accounts, func(a account) string { return a.id })
+
+		assert.NoError(t, err)
+		assert.Equal(t, account{"a3", 0}, result["a3"])
+		assert.Len(t, result, 4)
+	})
+
+	t.Run("Error_groupBy1By1Func_duplicates", func(t *testing.T) {
+		_, err := GroupBy1By1Func(accounts, sign)
+
+		var duplicateErr *DuplicateKeyError
+		assert.True(t, errors.As(err, &duplicateErr))
+		assert.EqualError(t, err, "groupBy: keys are not unique, duplicate keys: 'negative' at indices [0 3]")
+	})
+
+	t.Run("Success_groupBy1By1FuncWithPolicy_keepLast", func(t *testing.T) {
+		result, err := GroupBy1By1FuncWithPolicy(accounts, sign, KeepLast)
+
+		assert.NoError(t, err)
+		assert.Equal(t, account{"a4", -1}, result["negative"])
+	})
+}
+
+func TestSortedGroups(t *testing.T) {
+	type sale struct {
+		Region string
+		Amount int
+	}
+	groups := map[string][]sale{
+		"west":  {{"west", 30}, {"west", 10}},
+		"east":  {{"east", 5}, {"east", 50}, {"east", 20}},
+		"north": {},
+	}
+	byAmount := func(a, b sale) bool { return a.Amount < b.Amount }
+
+	t.Run("Success_doubly_sorted", func(t *testing.T) {
+		result := SortedGroups(groups, func(a, b string) bool { return a < b }, byAmount)
+
+		assert.Equal(t, []Group[string, sale]{
+			{Key: "east", Items: []sale{{"east", 5}, {"east", 20}, {"east", 50}}},
+			{Key: "north", Items: []sale{}},
+			{Key: "west", Items: []sale{{"west", 10}, {"west", 30}}},
+		}, result)
+		assert.Equal(t, []sale{{"west", 30}, {"west", 10}}, groups["west"])
+	})
+
+	t.Run("Success_nil_itemLess_keeps_order", func(t *testing.T) {
+		result := SortedGroups(groups, func(a, b string) bool { return a > b }, nil)
+
+		assert.Equal(t, "west", result[0].Key)
+		assert.Equal(t, []sale{{"west", 30}, {"west", 10}}, result[0].Items)
+	})
+}
+
+func TestGroupByTransformAndAggregate(t *testing.T) {
+	type sale struct {
+		Region string
+		Amount int
+	}
+	sales := []sale{{"west", 30}, {"east", 5}, {"west", 10}, {"east", 20}, {"north", 1}}
+	region := func(s sale) string { return s.Region }
+
+	t.Run("Success_groupByTransform", func(t *testing.T) {
+		result := GroupByTransform(sales, region, func(s sale) int { return s.Amount })
+
+		assert.Equal(t, map[string][]int{"west": {30, 10}, "east": {5, 20}, "north": {1}}, result)
+	})
+
+	t.Run("Success_groupByAggregate_sum", func(t *testing.T) {
+		result := GroupByAggregate(sales, region, 0, func(acc int, s sale) int { return acc + s.Amount })
+
+		assert.Equal(t, map[string]int{"west": 40, "east": 25, "north": 1}, result)
+	})
+
+	t.Run("Success_groupByAggregate_struct_accumulator", func(t *testing.T) {
+		type stats struct {
+			Count int
+			Max   int
+		}
+		result := GroupByAggregate(sales, region, stats{}, func(acc stats, s sale) stats {
+			return stats{Count: acc.Count + 1, Max: max(acc.Max, s.Amount)}
+		})
+
+		assert.Equal(t, map[string]stats{"west": {2, 30}, "east": {2, 20}, "north": {1, 1}}, result)
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		assert.Empty(t, GroupByAggregate([]sale{}, region, 0, func(acc int, s sale) int { return acc + 1 }))
+	})
+}
+
+func TestReduceGroups(t *testing.T) {
+	type Person struct {
+		Name string
+		Age  int
+	}
+	people := []Person{{"Alice", 30}, {"Bob", 30}, {"Charlie", 25}}
+
+	t.Run("Success_after_groupBy", func(t *testing.T) {
+		groups, err := GroupBy[int](people, "Age")
+		assert.NoError(t, err)
+
+		names := ReduceGroups(groups, func(acc string, p Person) string { return acc + p.Name[:1] }, "")
+
+		assert.Equal(t, map[int]string{30: "AB", 25: "C"}, names)
+	})
+
+	t.Run("Success_empty_group_gets_initial_value", func(t *testing.T) {
+		counts := ReduceGroups(map[string][]Person{"none": {}}, func(acc int, p Person) int { return acc + 1 }, 0)
+
+		assert.Equal(t, map[string]int{"none": 0}, counts)
+	})
 }
@@ -0,0 +1,32 @@
+package grouping
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// GroupsToJSON encodes a grouped result as a JSON array of {"key", "items"} objects ordered by keyLess,
+// so any comparable key type is supported and the output is stable across runs.
+func GroupsToJSON[K comparable, V any](groups map[K][]V, keyLess func(a, b K) bool) ([]byte, error) {
+	data, err := json.Marshal(SortedGroups(groups, keyLess, nil))
+	if err != nil {
+		return nil, fmt.Errorf("groupsToJSON: %w", err)
+	}
+	return data, nil
+}
+
+// GroupsFromJSON decodes a grouped result encoded by GroupsToJSON.
+func GroupsFromJSON[K comparable, V any](data []byte) (map[K][]V, error) {
+	ordered := []Group[K, V]{}
+	if err := json.Unmarshal(data, &ordered); err != nil {
+		return nil, fmt.Errorf("groupsFromJSON: %w", err)
+	}
+	result := make(map[K][]V, len(ordered))
+	for _, group := range ordered {
+		if _, exists := result[group.Key]; exists {
+			return nil, fmt.Errorf("groupsFromJSON: key %v is duplicated", group.Key)
+		}
+		result[group.Key] = group.Items
+	}
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package grouping
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupsToJSON(t *testing.T) {
+	type Person struct {
+		Name string `json:"name"`
+		Age  int    `json:"age"`
+	}
+
+	t.Run("Success_int_keys_ordered", func(t *testing.T) {
+		groups := map[int][]Person{
+			30: {{Name: "Alice", Age: 30}},
+			25: {{Name: "Charlie", Age: 25}},
+		}
+
+		data, err := GroupsToJSON(groups, func(a, b int) bool { return a < b })
+		assert.NoError(t, err)
+
+		expected := `[{"key":25,"items":[{"name":"Charlie","age":25}]},{"key":30,"items":[{"name":"Alice","age":30}]}]`
+		assert.Equal(t, expected, string(data))
+	})
+
+	t.Run("Success_struct_keys", func(t *testing.T) {
+		type Key struct {
+			Country string `json:"country"`
+			Year    int    `json:"year"`
+		}
+		groups := map[Key][]int{
+			{Country: "TH", Year: 2024}: {1, 2},
+			{Country: "JP", Year: 2024}: {3},
+		}
+
+		data, err := GroupsToJSON(groups, func(a, b Key) bool { return a.Country < b.Country })
+		assert.NoError(t, err)
+
+		expected := `[{"key":{"country":"JP","year":2024},"items":[3]},{"key":{"country":"TH","year":2024},"items":[1,2]}]`
+		assert.Equal(t, expected, string(data))
+
+		decoded, err := GroupsFromJSON[Key, int](data)
+		assert.NoError(t, err)
+		assert.Equal(t, groups, decoded)
+	})
+
+	t.Run("Error_unsupported_value", func(t *testing.T) {
+		groups := map[int][]func(){1: {func() {}}}
+
+		data, err := GroupsToJSON(groups, func(a, b int) bool { return a < b })
+
+		assert.Nil(t, data)
+		assert.ErrorContains(t, err, "groupsToJSON:")
+	})
+}
+
+func TestGroupsFromJSON(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result, err := GroupsFromJSON[float64, string]([]byte(`[{"key":1.5,"items":["a","b"]},{"key":2,"items":[]}]`))
+		assert.NoError(t, err)
+
+		assert.Equal(t, map[float64][]string{1.5: {"a", "b"}, 2: {}}, result)
+	})
+
+	t.Run("Error_duplicate_key", func(t *testing.T) {
+		result, err := GroupsFromJSON[int, string]([]byte(`[{"key":1,"items":["a"]},{"key":1,"items":["b"]}]`))
+
+		assert.Nil(t, result)
+		assert.Equal(t, "groupsFromJSON: key 1 is duplicated", err.Error())
+	})
+
+	t.Run("Error_invalid_json", func(t *testing.T) {
+		result, err := GroupsFromJSON[int, string]([]byte(`{`))
+
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "groupsFromJSON:")
+	})
+}
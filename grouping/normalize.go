@@ -0,0 +1,38 @@
+package grouping
+
+import (
+	"math"
+	"time"
+)
+
+// NormalizeTime returns a key normalizer mapping a time to the start of its day in loc, e.g.
+// NormalizeTime(time.UTC) for daily buckets. The result carries no monotonic clock reading, so equal
+// days compare equal as map keys regardless of the original location.
+func NormalizeTime(loc *time.Location) func(key time.Time) time.Time {
+	return func(key time.Time) time.Time {
+		year, month, day := key.In(loc).Date()
+		return time.Date(year, month, day, 0, 0, 0, 0, loc)
+	}
+}
+
+// TruncateTime returns a key normalizer converting a time to UTC and truncating it to a multiple of
+// precision since the zero time, e.g. TruncateTime(time.Hour) for hourly buckets. The monotonic clock
+// reading is dropped.
+func TruncateTime(precision time.Duration) func(key time.Time) time.Time {
+	return func(key time.Time) time.Time {
+		return key.UTC().Truncate(precision).Round(0)
+	}
+}
+
+// RoundFloat returns a key normalizer rounding a float to the given number of decimals, so that values
+// differing only by floating-point noise, like 0.1+0.2 and 0.3, share a group.
+func RoundFloat(decimals int) func(key float64) float64 {
+	scale := math.Pow(10, float64(decimals))
+	return func(key float64) float64 {
+		rounded := math.Round(key*scale) / scale
+		if rounded == 0 {
+			return 0
+		}
+		return rounded
+	}
+}
@@ -0,0 +1,65 @@
+package grouping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupByNormalized(t *testing.T) {
+	tenth, fifth := 0.1, 0.2
+	bangkok := time.FixedZone("ICT", 7*60*60)
+
+	t.Run("Success_time_by_utc_day", func(t *testing.T) {
+		type Event struct {
+			Name string
+			At   time.Time
+		}
+		events := []Event{
+			{"a", time.Date(2024, 3, 1, 23, 30, 0, 0, time.UTC)},
+			{"b", time.Date(2024, 3, 2, 6, 0, 0, 0, bangkok)},
+			{"c", time.Now()},
+			{"d", time.Date(2024, 3, 2, 8, 0, 0, 0, bangkok)},
+		}
+
+		result, err := GroupByNormalized[time.Time](events[:2], "At", NormalizeTime(time.UTC))
+		assert.NoError(t, err)
+		assert.Equal(t, map[time.Time][]Event{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC): events[:2]}, result)
+
+		result, err = GroupByNormalized[time.Time](events[1:], "At", NormalizeTime(bangkok))
+		assert.NoError(t, err)
+		assert.Len(t, result[time.Date(2024, 3, 2, 0, 0, 0, 0, bangkok)], 2)
+	})
+
+	t.Run("Success_truncate_drops_monotonic_and_location", func(t *testing.T) {
+		now := time.Now()
+		hour := TruncateTime(time.Hour)
+
+		assert.Equal(t, hour(now), hour(now.In(bangkok).Round(0)))
+		assert.Equal(t, time.UTC, hour(now).Location())
+	})
+
+	t.Run("Success_float_rounding", func(t *testing.T) {
+		type Reading struct {
+			Value float64
+		}
+		readings := []Reading{{tenth + fifth}, {0.3}, {-0.0001}, {0}}
+
+		result, err := GroupByNormalized[float64](readings, "Value", RoundFloat(2))
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[float64][]Reading{0.3: readings[:2], 0: readings[2:]}, result)
+	})
+
+	t.Run("Success_nil_normalizer", func(t *testing.T) {
+		type Reading struct {
+			Value float64
+		}
+
+		result, err := GroupByNormalized[float64]([]Reading{{tenth + fifth}, {0.3}}, "Value", nil)
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+	})
+}
@@ -0,0 +1,173 @@
+package hashing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"reflect"
+)
+
+// HashValue returns a stable 64-bit FNV-1a hash of a value, identical across runs and processes.
+// Structs, arrays, slices, maps, pointers and interfaces are hashed by content; map entries are
+// combined independently of iteration order. Functions, channels and cyclic values return an error.
+func HashValue(value any) (uint64, error) {
+	hasher := &valueHasher{visited: make(map[uintptr]bool)}
+	if err := hasher.write(reflect.ValueOf(value)); err != nil {
+		return 0, err
+	}
+	return hasher.sum, nil
+}
+
+// HashComparable returns the same hash as HashValue, with a fast path for common primitive types.
+func HashComparable[T comparable](value T) (uint64, error) {
+	switch v := any(value).(type) {
+	case string:
+		return hashBytes(byte(reflect.String), []byte(v)), nil
+	case int:
+		return hashUint(byte(reflect.Int64), uint64(v)), nil
+	case int64:
+		return hashUint(byte(reflect.Int64), uint64(v)), nil
+	case uint64:
+		return hashUint(byte(reflect.Uint64), v), nil
+	case bool:
+		return hashUint(byte(reflect.Bool), boolToUint(v)), nil
+	}
+	return HashValue(value)
+}
+
+type valueHasher struct {
+	sum     uint64
+	visited map[uintptr]bool
+}
+
+// mix folds a partial hash into the running sum, keeping the combination order-sensitive.
+func (h *valueHasher) mix(partial uint64) {
+	h.sum = h.sum*1099511628211 ^ partial
+}
+
+func (h *valueHasher) write(value reflect.Value) error {
+	if !value.IsValid() {
+		h.mix(hashUint(byte(reflect.Invalid), 0))
+		return nil
+	}
+	kind := value.Kind()
+	switch kind {
+	case reflect.Bool:
+		h.mix(hashUint(byte(kind), boolToUint(value.Bool())))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		h.mix(hashUint(byte(reflect.Int64), uint64(value.Int())))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		h.mix(hashUint(byte(reflect.Uint64), value.Uint()))
+	case reflect.Float32, reflect.Float64:
+		h.mix(hashUint(byte(reflect.Float64), floatBits(value.Float())))
+	case reflect.Complex64, reflect.Complex128:
+		c := value.Complex()
+		h.mix(hashUint(byte(reflect.Complex128), floatBits(real(c))))
+		h.mix(hashUint(byte(reflect.Complex128), floatBits(imag(c))))
+	case reflect.String:
+		h.mix(hashBytes(byte(kind), []byte(value.String())))
+	case reflect.Array, reflect.Slice:
+		if kind == reflect.Slice && value.IsNil() {
+			h.mix(hashUint(byte(kind), 0))
+			return nil
+		}
+		if kind == reflect.Slice && value.Len() > 0 {
+			if err := h.enter(value.Pointer()); err != nil {
+				return err
+			}
+			defer h.leave(value.Pointer())
+		}
+		h.mix(hashUint(byte(reflect.Slice), uint64(value.Len())))
+		for i := 0; i < value.Len(); i++ {
+			if err := h.write(value.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Struct:
+		h.mix(hashBytes(byte(kind), []byte(value.Type().String())))
+		for i := 0; i < value.NumField(); i++ {
+			if err := h.write(value.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if value.IsNil() {
+			h.mix(hashUint(byte(kind), 0))
+			return nil
+		}
+		if err := h.enter(value.Pointer()); err != nil {
+			return err
+		}
+		defer h.leave(value.Pointer())
+		var combined uint64
+		iter := value.MapRange()
+		for iter.Next() {
+			entry := &valueHasher{visited: h.visited}
+			if err := entry.write(iter.Key()); err != nil {
+				return err
+			}
+			if err := entry.write(iter.Value()); err != nil {
+				return err
+			}
+			combined += entry.sum
+		}
+		h.mix(hashUint(byte(kind), uint64(value.Len())))
+		h.mix(combined)
+	case reflect.Pointer:
+		if value.IsNil() {
+			h.mix(hashUint(byte(kind), 0))
+			return nil
+		}
+		if err := h.enter(value.Pointer()); err != nil {
+			return err
+		}
+		defer h.leave(value.Pointer())
+		return h.write(value.Elem())
+	case reflect.Interface:
+		return h.write(value.Elem())
+	default:
+		return fmt.Errorf("hashValue: unsupported kind %s", kind)
+	}
+	return nil
+}
+
+// floatBits returns the bits of a float with -0 normalized to +0, since the two compare equal.
+func floatBits(value float64) uint64 {
+	if value == 0 {
+		return 0
+	}
+	return math.Float64bits(value)
+}
+
+func (h *valueHasher) enter(pointer uintptr) error {
+	if h.visited[pointer] {
+		return fmt.Errorf("hashValue: cyclic value")
+	}
+	h.visited[pointer] = true
+	return nil
+}
+
+func (h *valueHasher) leave(pointer uintptr) {
+	delete(h.visited, pointer)
+}
+
+func hashUint(tag byte, value uint64) uint64 {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, value)
+	return hashBytes(tag, buf)
+}
+
+func hashBytes(tag byte, data []byte) uint64 {
+	hasher := fnv.New64a()
+	hasher.Write([]byte{tag})
+	hasher.Write(data)
+	return hasher.Sum64()
+}
+
+func boolToUint(value bool) uint64 {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,156 @@
+package hashing
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type hashNode struct {
+	Value int
+	Next  *hashNode
+}
+
+func TestHashValue(t *testing.T) {
+	type Person struct {
+		Name string
+		Tags []string
+		Meta map[string]int
+	}
+
+	t.Run("Success_equal_values_equal_hashes", func(t *testing.T) {
+		a := Person{Name: "Alice", Tags: []string{"x", "y"}, Meta: map[string]int{"a": 1, "b": 2, "c": 3}}
+		b := Person{Name: "Alice", Tags: []string{"x", "y"}, Meta: map[string]int{"c": 3, "b": 2, "a": 1}}
+
+		hashA, err := HashValue(a)
+		assert.NoError(t, err)
+		hashB, err := HashValue(b)
+		assert.NoError(t, err)
+
+		assert.Equal(t, hashA, hashB)
+	})
+
+	t.Run("Success_different_values_different_hashes", func(t *testing.T) {
+		values := []any{
+			1, 2, "1", "a", true, false, 1.5, nil,
+			[]int{1, 2}, []int{2, 1}, []int{12},
+			Person{Name: "Alice"}, Person{Name: "Bob"},
+			map[string]int{"a": 1}, map[string]int{"a": 2},
+		}
+		seen := map[uint64]any{}
+		for _, value := range values {
+			hash, err := HashValue(value)
+			assert.NoError(t, err)
+			previous, exists := seen[hash]
+			assert.False(t, exists, "hash collision between %#v and %#v", value, previous)
+			seen[hash] = value
+		}
+	})
+
+	t.Run("Success_stable_across_runs", func(t *testing.T) {
+		hash, err := HashValue("hello")
+		assert.NoError(t, err)
+
+		assert.Equal(t, uint64(0x7c3ba046e683f61d), hash)
+	})
+
+	t.Run("Success_pointer_hashes_content", func(t *testing.T) {
+		first, second := 5, 5
+
+		hashFirst, err := HashValue(&first)
+		assert.NoError(t, err)
+		hashSecond, err := HashValue(&second)
+		assert.NoError(t, err)
+
+		assert.Equal(t, hashFirst, hashSecond)
+	})
+
+	t.Run("Error_unsupported_kind", func(t *testing.T) {
+		_, err := HashValue(func() {})
+
+		assert.Equal(t, "hashValue: unsupported kind func", err.Error())
+	})
+
+	t.Run("Error_cyclic_value", func(t *testing.T) {
+		node := &hashNode{Value: 1}
+		node.Next = node
+
+		_, err := HashValue(node)
+
+		assert.Equal(t, "hashValue: cyclic value", err.Error())
+	})
+
+	t.Run("Error_cyclic_slice", func(t *testing.T) {
+		list := []any{nil}
+		list[0] = list
+
+		_, err := HashValue(list)
+
+		assert.Equal(t, "hashValue: cyclic value", err.Error())
+	})
+
+	t.Run("Success_shared_slice_is_not_cyclic", func(t *testing.T) {
+		shared := []int{1, 2}
+
+		_, err := HashValue([][]int{shared, shared})
+
+		assert.NoError(t, err)
+	})
+}
+
+func TestHashComparable(t *testing.T) {
+	type Key struct {
+		Country string
+		Year    int
+	}
+
+	t.Run("Success_matches_HashValue", func(t *testing.T) {
+		for _, value := range []any{"abc", 42, int64(-7), uint64(9), true} {
+			expected, err := HashValue(value)
+			assert.NoError(t, err)
+
+			var actual uint64
+			switch v := value.(type) {
+			case string:
+				actual, err = HashComparable(v)
+			case int:
+				actual, err = HashComparable(v)
+			case int64:
+				actual, err = HashComparable(v)
+			case uint64:
+				actual, err = HashComparable(v)
+			case bool:
+				actual, err = HashComparable(v)
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, expected, actual, "value %#v", value)
+		}
+	})
+
+	t.Run("Success_struct_fallback", func(t *testing.T) {
+		expected, err := HashValue(Key{Country: "TH", Year: 2024})
+		assert.NoError(t, err)
+
+		actual, err := HashComparable(Key{Country: "TH", Year: 2024})
+		assert.NoError(t, err)
+
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Success_negative_zero_equals_zero", func(t *testing.T) {
+		negativeZero := math.Copysign(0, -1)
+
+		positive, err := HashComparable(0.0)
+		assert.NoError(t, err)
+		negative, err := HashComparable(negativeZero)
+		assert.NoError(t, err)
+		assert.Equal(t, positive, negative)
+
+		positive, err = HashComparable(complex(0, 0))
+		assert.NoError(t, err)
+		negative, err = HashComparable(complex(negativeZero, negativeZero))
+		assert.NoError(t, err)
+		assert.Equal(t, positive, negative)
+	})
+}
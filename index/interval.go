@@ -0,0 +1,132 @@
+package index
+
+import (
+	"cmp"
+	"fmt"
+)
+
+// IntervalTree stores values keyed by closed intervals [lo, hi] and finds those overlapping a point
+// or range in logarithmic time plus the number of matches. It is kept balanced as an AVL tree.
+type IntervalTree[K cmp.Ordered, V any] struct {
+	root *intervalNode[K, V]
+	size int
+}
+
+type intervalNode[K cmp.Ordered, V any] struct {
+	lo, hi      K
+	value       V
+	maxHi       K
+	height      int
+	left, right *intervalNode[K, V]
+}
+
+// NewIntervalTree creates an empty IntervalTree.
+func NewIntervalTree[K cmp.Ordered, V any]() *IntervalTree[K, V] {
+	return &IntervalTree[K, V]{}
+}
+
+// Len returns the number of stored intervals.
+func (t *IntervalTree[K, V]) Len() int {
+	return t.size
+}
+
+// Insert stores a value for the closed interval [lo, hi]. Overlapping intervals are allowed.
+func (t *IntervalTree[K, V]) Insert(lo, hi K, value V) error {
+	if hi < lo {
+		return fmt.Errorf("intervalTree: interval [%v, %v] has lo greater than hi", lo, hi)
+	}
+	t.root = t.root.insert(&intervalNode[K, V]{lo: lo, hi: hi, value: value, maxHi: hi, height: 1})
+	t.size++
+	return nil
+}
+
+// Query returns the values whose interval contains the point, ordered by interval start.
+func (t *IntervalTree[K, V]) Query(point K) []V {
+	return t.QueryRange(point, point)
+}
+
+// QueryRange returns the values whose interval overlaps [lo, hi], ordered by interval start.
+func (t *IntervalTree[K, V]) QueryRange(lo, hi K) []V {
+	result := []V{}
+	t.root.collect(lo, hi, &result)
+	return result
+}
+
+func (n *intervalNode[K, V]) collect(lo, hi K, result *[]V) {
+	if n == nil || n.maxHi < lo {
+		return
+	}
+	n.left.collect(lo, hi, result)
+	if n.lo <= hi && lo <= n.hi {
+		*result = append(*result, n.value)
+	}
+	if n.lo <= hi {
+		n.right.collect(lo, hi, result)
+	}
+}
+
+func (n *intervalNode[K, V]) insert(node *intervalNode[K, V]) *intervalNode[K, V] {
+	if n == nil {
+		return node
+	}
+	if node.lo < n.lo {
+		n.left = n.left.insert(node)
+	} else {
+		n.right = n.right.insert(node)
+	}
+	return n.rebalance()
+}
+
+func (n *intervalNode[K, V]) heightOf() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *intervalNode[K, V]) update() {
+	n.height = 1 + max(n.left.heightOf(), n.right.heightOf())
+	n.maxHi = n.hi
+	if n.left != nil {
+		n.maxHi = max(n.maxHi, n.left.maxHi)
+	}
+	if n.right != nil {
+		n.maxHi = max(n.maxHi, n.right.maxHi)
+	}
+}
+
+func (n *intervalNode[K, V]) rotateLeft() *intervalNode[K, V] {
+	pivot := n.right
+	n.right = pivot.left
+	pivot.left = n
+	n.update()
+	pivot.update()
+	return pivot
+}
+
+func (n *intervalNode[K, V]) rotateRight() *intervalNode[K, V] {
+	pivot := n.left
+	n.left = pivot.right
+	pivot.right = n
+	n.update()
+	pivot.update()
+	return pivot
+}
+
+func (n *intervalNode[K, V]) rebalance() *intervalNode[K, V] {
+	n.update()
+	balance := n.left.heightOf() - n.right.heightOf()
+	if balance > 1 {
+		if n.left.left.heightOf() < n.left.right.heightOf() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	}
+	if balance < -1 {
+		if n.right.right.heightOf() < n.right.left.heightOf() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	}
+	return n
+}
@@ -0,0 +1,73 @@
+package index
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntervalTree(t *testing.T) {
+	t.Run("Success_price_tiers", func(t *testing.T) {
+		tiers := NewIntervalTree[int, string]()
+		assert.NoError(t, tiers.Insert(1, 9, "retail"))
+		assert.NoError(t, tiers.Insert(10, 99, "wholesale"))
+		assert.NoError(t, tiers.Insert(100, 1000, "bulk"))
+
+		assert.Equal(t, []string{"retail"}, tiers.Query(1))
+		assert.Equal(t, []string{"wholesale"}, tiers.Query(10))
+		assert.Equal(t, []string{"bulk"}, tiers.Query(1000))
+		assert.Equal(t, []string{}, tiers.Query(1001))
+		assert.Equal(t, 3, tiers.Len())
+	})
+
+	t.Run("Success_overlapping_intervals_and_range", func(t *testing.T) {
+		tree := NewIntervalTree[float64, int]()
+		assert.NoError(t, tree.Insert(0, 5, 1))
+		assert.NoError(t, tree.Insert(3, 8, 2))
+		assert.NoError(t, tree.Insert(7, 7, 3))
+		assert.NoError(t, tree.Insert(9, 12, 4))
+
+		assert.Equal(t, []int{1, 2}, tree.Query(4))
+		assert.Equal(t, []int{2, 3}, tree.Query(7))
+		assert.Equal(t, []int{2, 3, 4}, tree.QueryRange(6, 9))
+		assert.Equal(t, []int{}, tree.QueryRange(13, 20))
+	})
+
+	t.Run("Success_matches_linear_scan", func(t *testing.T) {
+		type interval struct{ lo, hi, id int }
+		random := rand.New(rand.NewSource(1))
+		tree := NewIntervalTree[int, int]()
+		intervals := []interval{}
+		for i := 0; i < 500; i++ {
+			lo := random.Intn(1000)
+			hi := lo + random.Intn(50)
+			intervals = append(intervals, interval{lo: lo, hi: hi, id: i})
+			assert.NoError(t, tree.Insert(lo, hi, i))
+		}
+
+		for point := 0; point < 1050; point += 7 {
+			expected := map[int]bool{}
+			for _, iv := range intervals {
+				if iv.lo <= point && point <= iv.hi {
+					expected[iv.id] = true
+				}
+			}
+			actual := map[int]bool{}
+			for _, id := range tree.Query(point) {
+				actual[id] = true
+			}
+			assert.Equal(t, expected, actual, "point %d", point)
+		}
+		assert.LessOrEqual(t, tree.root.height, 13)
+	})
+
+	t.Run("Error_inverted_interval", func(t *testing.T) {
+		tree := NewIntervalTree[string, int]()
+
+		err := tree.Insert("z", "a", 1)
+
+		assert.EqualError(t, err, "intervalTree: interval [z, a] has lo greater than hi")
+		assert.Equal(t, 0, tree.Len())
+	})
+}
@@ -0,0 +1,72 @@
+package index
+
+import "sort"
+
+// PrefixIndex is a trie over string keys answering prefix queries without scanning every item.
+type PrefixIndex[T any] struct {
+	root *prefixNode[T]
+}
+
+type prefixNode[T any] struct {
+	children map[byte]*prefixNode[T]
+	items    []T
+}
+
+// NewPrefixIndex builds a PrefixIndex keyed by the string selected from each item.
+func NewPrefixIndex[T any](items []T, keyFunc func(item T) string) *PrefixIndex[T] {
+	index := &PrefixIndex[T]{root: newPrefixNode[T]()}
+	for _, item := range items {
+		index.Add(keyFunc(item), item)
+	}
+	return index
+}
+
+// NewStringPrefixIndex builds a PrefixIndex over the strings themselves.
+func NewStringPrefixIndex(items []string) *PrefixIndex[string] {
+	return NewPrefixIndex(items, func(item string) string { return item })
+}
+
+func newPrefixNode[T any]() *prefixNode[T] {
+	return &prefixNode[T]{children: make(map[byte]*prefixNode[T])}
+}
+
+// Add inserts an item under the given key.
+func (p *PrefixIndex[T]) Add(key string, item T) {
+	node := p.root
+	for i := 0; i < len(key); i++ {
+		child, exists := node.children[key[i]]
+		if !exists {
+			child = newPrefixNode[T]()
+			node.children[key[i]] = child
+		}
+		node = child
+	}
+	node.items = append(node.items, item)
+}
+
+// WithPrefix returns the items whose key starts with the prefix, ordered by key
+// and, for equal keys, by insertion order. An empty prefix returns every item.
+func (p *PrefixIndex[T]) WithPrefix(prefix string) []T {
+	node := p.root
+	for i := 0; i < len(prefix); i++ {
+		child, exists := node.children[prefix[i]]
+		if !exists {
+			return []T{}
+		}
+		node = child
+	}
+	return node.collect([]T{})
+}
+
+func (n *prefixNode[T]) collect(result []T) []T {
+	result = append(result, n.items...)
+	keys := make([]byte, 0, len(n.children))
+	for key := range n.children {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, key := range keys {
+		result = n.children[key].collect(result)
+	}
+	return result
+}
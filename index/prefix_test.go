@@ -0,0 +1,44 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixIndex(t *testing.T) {
+	t.Run("Success_strings", func(t *testing.T) {
+		index := NewStringPrefixIndex([]string{"banana", "apple", "apricot", "app", "band"})
+
+		assert.Equal(t, []string{"app", "apple", "apricot"}, index.WithPrefix("ap"))
+		assert.Equal(t, []string{"app", "apple"}, index.WithPrefix("app"))
+		assert.Equal(t, []string{"banana", "band"}, index.WithPrefix("ban"))
+		assert.Equal(t, []string{}, index.WithPrefix("cherry"))
+		assert.Equal(t, []string{"app", "apple", "apricot", "banana", "band"}, index.WithPrefix(""))
+	})
+
+	t.Run("Success_key_func", func(t *testing.T) {
+		type City struct {
+			Name       string
+			Population int
+		}
+		cities := []City{
+			{Name: "Bangkok", Population: 10},
+			{Name: "Bangalore", Population: 12},
+			{Name: "Berlin", Population: 3},
+			{Name: "Bangkok", Population: 11},
+		}
+
+		index := NewPrefixIndex(cities, func(c City) string { return c.Name })
+
+		assert.Equal(t, []City{cities[1], cities[0], cities[3]}, index.WithPrefix("Bang"))
+	})
+
+	t.Run("Success_add_after_build", func(t *testing.T) {
+		index := NewStringPrefixIndex([]string{})
+
+		index.Add("go", "go")
+
+		assert.Equal(t, []string{"go"}, index.WithPrefix("g"))
+	})
+}
@@ -0,0 +1,114 @@
+package maps
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// CIMap is a string-keyed map whose lookups ignore case, e.g. for HTTP headers or user-supplied
+// configuration keys. It remembers the spelling of the key last set. The zero value is an empty map
+// ready to use. It is not safe for concurrent use.
+type CIMap[V any] struct {
+	entries map[string]Pair[string, V]
+}
+
+// NewCIMap creates an empty CIMap.
+func NewCIMap[V any]() *CIMap[V] {
+	return &CIMap[V]{entries: make(map[string]Pair[string, V])}
+}
+
+// CIMapFromMap copies a map into a CIMap. It fails when two keys differ only in case.
+func CIMapFromMap[V any](source map[string]V) (*CIMap[V], error) {
+	result := NewCIMap[V]()
+	for _, key := range SortedKeysBy(source, func(a, b string) bool { return a < b }) {
+		if existing, exists := result.entries[ciKey(key)]; exists {
+			return nil, errors.Errorf("error building case-insensitive map, key:'%v' collides with key:'%v'", key, existing.Key)
+		}
+		result.Set(key, source[key])
+	}
+	return result, nil
+}
+
+func ciKey(key string) string {
+	return strings.ToLower(key)
+}
+
+// Set stores the value under the key, replacing any value stored under a differently cased key.
+func (m *CIMap[V]) Set(key string, value V) {
+	if m.entries == nil {
+		m.entries = make(map[string]Pair[string, V])
+	}
+	m.entries[ciKey(key)] = Pair[string, V]{Key: key, Value: value}
+}
+
+// Get returns the value stored under the key in any case.
+func (m *CIMap[V]) Get(key string) (V, bool) {
+	entry, exists := m.entries[ciKey(key)]
+	return entry.Value, exists
+}
+
+// GetOrDefault returns the value stored under the key in any case, or fallback.
+func (m *CIMap[V]) GetOrDefault(key string, fallback V) V {
+	if value, exists := m.Get(key); exists {
+		return value
+	}
+	return fallback
+}
+
+// Has reports whether a value is stored under the key in any case.
+func (m *CIMap[V]) Has(key string) bool {
+	_, exists := m.entries[ciKey(key)]
+	return exists
+}
+
+// Delete removes the value stored under the key in any case.
+func (m *CIMap[V]) Delete(key string) {
+	delete(m.entries, ciKey(key))
+}
+
+// Len returns the number of entries.
+func (m *CIMap[V]) Len() int {
+	return len(m.entries)
+}
+
+// Keys returns the keys with their stored spelling, sorted.
+func (m *CIMap[V]) Keys() []string {
+	keys := make([]string, 0, len(m.entries))
+	for _, entry := range m.entries {
+		keys = append(keys, entry.Key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ToMap returns a plain map keyed by the stored spelling of each key, for use with the other helpers
+// of this package.
+func (m *CIMap[V]) ToMap() map[string]V {
+	result := make(map[string]V, len(m.entries))
+	for _, entry := range m.entries {
+		result[entry.Key] = entry.Value
+	}
+	return result
+}
+
+// Filter returns a new CIMap with the entries accepted by the filtering function.
+func (m *CIMap[V]) Filter(filteringFunc func(key string, value V) bool) *CIMap[V] {
+	result := NewCIMap[V]()
+	for folded, entry := range m.entries {
+		if filteringFunc(entry.Key, entry.Value) {
+			result.entries[folded] = entry
+		}
+	}
+	return result
+}
+
+// MapCIMapValues applies a transformation function to the values of a CIMap, keeping the keys.
+func MapCIMapValues[V1 any, V2 any](source *CIMap[V1], mappingFunc func(key string, value V1) V2) *CIMap[V2] {
+	result := NewCIMap[V2]()
+	for folded, entry := range source.entries {
+		result.entries[folded] = Pair[string, V2]{Key: entry.Key, Value: mappingFunc(entry.Key, entry.Value)}
+	}
+	return result
+}
@@ -0,0 +1,56 @@
+package maps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCIMap(t *testing.T) {
+	t.Run("Success_case_insensitive_lookup", func(t *testing.T) {
+		headers := NewCIMap[string]()
+		headers.Set("Content-Type", "application/json")
+		headers.Set("X-Request-ID", "abc")
+		headers.Set("x-request-id", "def")
+
+		value, ok := headers.Get("CONTENT-TYPE")
+		assert.True(t, ok)
+		assert.Equal(t, "application/json", value)
+		assert.Equal(t, "def", headers.GetOrDefault("X-Request-Id", ""))
+		assert.Equal(t, "none", headers.GetOrDefault("Accept", "none"))
+		assert.Equal(t, 2, headers.Len())
+		assert.Equal(t, []string{"Content-Type", "x-request-id"}, headers.Keys())
+
+		headers.Delete("content-TYPE")
+		assert.False(t, headers.Has("Content-Type"))
+	})
+
+	t.Run("Success_zero_value", func(t *testing.T) {
+		var headers CIMap[string]
+		assert.Equal(t, 0, headers.Len())
+		assert.False(t, headers.Has("Accept"))
+
+		headers.Set("Accept", "text/html")
+
+		assert.Equal(t, "text/html", headers.GetOrDefault("ACCEPT", ""))
+		assert.Equal(t, []string{"Accept"}, headers.Keys())
+	})
+
+	t.Run("Success_helpers", func(t *testing.T) {
+		config, err := CIMapFromMap(map[string]int{"Timeout": 30, "Retries": 3, "Port": 8080})
+		assert.NoError(t, err)
+
+		small := config.Filter(func(key string, value int) bool { return value < 100 })
+		labels := MapCIMapValues(small, func(key string, value int) string { return strings.ToLower(key) })
+
+		assert.Equal(t, map[string]int{"Timeout": 30, "Retries": 3}, small.ToMap())
+		assert.Equal(t, "retries", labels.GetOrDefault("RETRIES", ""))
+	})
+
+	t.Run("Error_colliding_keys", func(t *testing.T) {
+		_, err := CIMapFromMap(map[string]int{"Timeout": 30, "TIMEOUT": 60})
+
+		assert.EqualError(t, err, "error building case-insensitive map, key:'Timeout' collides with key:'TIMEOUT'")
+	})
+}
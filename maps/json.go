@@ -0,0 +1,66 @@
+package maps
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MarshalOrderedJSON encodes a hashmap as a JSON object whose keys appear in the order given by keyLess,
+// so responses built from map results are byte-for-byte stable. Keys are encoded like encoding/json does:
+// string kinds as is, even with a MarshalText method, then encoding.TextMarshaler keys through MarshalText
+// and integers in decimal.
+func MarshalOrderedJSON[K comparable, V any](source map[K]V, keyLess func(a, b K) bool) ([]byte, error) {
+	if source == nil {
+		return []byte("null"), nil
+	}
+	var buffer bytes.Buffer
+	buffer.WriteByte('{')
+	for idx, key := range SortedKeysBy(source, keyLess) {
+		name, err := jsonKey(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedName, err := json.Marshal(name)
+		if err != nil {
+			return nil, fmt.Errorf("marshalOrderedJSON: key %v: %w", key, err)
+		}
+		encodedValue, err := json.Marshal(source[key])
+		if err != nil {
+			return nil, fmt.Errorf("marshalOrderedJSON: value of key %v: %w", key, err)
+		}
+		if idx > 0 {
+			buffer.WriteByte(',')
+		}
+		buffer.Write(encodedName)
+		buffer.WriteByte(':')
+		buffer.Write(encodedValue)
+	}
+	buffer.WriteByte('}')
+	return buffer.Bytes(), nil
+}
+
+// jsonKey renders a map key as a JSON object name.
+func jsonKey[K comparable](key K) (string, error) {
+	value := reflect.ValueOf(key)
+	if value.Kind() == reflect.String {
+		return value.String(), nil
+	}
+	if marshaler, ok := any(key).(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return "", fmt.Errorf("marshalOrderedJSON: key %v: %w", key, err)
+		}
+		return string(text), nil
+	}
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("marshalOrderedJSON: unsupported key type %T", key)
+}
@@ -0,0 +1,69 @@
+package maps
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type jsonShoutKey string
+
+func (k jsonShoutKey) MarshalText() ([]byte, error) {
+	return []byte(strings.ToUpper(string(k))), nil
+}
+
+func TestMarshalOrderedJSON(t *testing.T) {
+	t.Run("Success_custom_order", func(t *testing.T) {
+		priority := map[string]int{"id": 0, "name": 1, "email": 2}
+		user := map[string]any{"email": "a@x", "id": 7, "name": "Alice"}
+
+		data, err := MarshalOrderedJSON(user, func(a, b string) bool { return priority[a] < priority[b] })
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"id":7,"name":"Alice","email":"a@x"}`, string(data))
+	})
+
+	t.Run("Success_integer_and_text_keys", func(t *testing.T) {
+		byYear, err := MarshalOrderedJSON(map[int][]string{2024: {"b"}, 2023: {"a"}}, func(a, b int) bool { return a < b })
+		assert.NoError(t, err)
+		assert.Equal(t, `{"2023":["a"],"2024":["b"]}`, string(byYear))
+
+		day := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+		byDay, err := MarshalOrderedJSON(map[time.Time]int{day: 3}, func(a, b time.Time) bool { return a.Before(b) })
+		assert.NoError(t, err)
+		assert.Equal(t, `{"2024-01-02T00:00:00Z":3}`, string(byDay))
+
+		var decoded map[string]int
+		assert.NoError(t, json.Unmarshal(byDay, &decoded))
+	})
+
+	t.Run("Success_string_kind_ignores_marshalText", func(t *testing.T) {
+		source := map[jsonShoutKey]int{"b": 2, "a": 1}
+
+		data, err := MarshalOrderedJSON(source, func(a, b jsonShoutKey) bool { return a < b })
+
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":1,"b":2}`, string(data))
+	})
+
+	t.Run("Success_nil_and_empty", func(t *testing.T) {
+		less := func(a, b string) bool { return a < b }
+
+		data, err := MarshalOrderedJSON[string, int](nil, less)
+		assert.NoError(t, err)
+		assert.Equal(t, "null", string(data))
+
+		data, err = MarshalOrderedJSON(map[string]int{}, less)
+		assert.NoError(t, err)
+		assert.Equal(t, "{}", string(data))
+	})
+
+	t.Run("Error_unsupported_key", func(t *testing.T) {
+		_, err := MarshalOrderedJSON(map[float64]int{1.5: 1}, func(a, b float64) bool { return a < b })
+
+		assert.EqualError(t, err, "marshalOrderedJSON: unsupported key type float64")
+	})
+}
@@ -1,7 +1,9 @@
 package maps
 
 import (
+	"container/heap"
 	"fmt"
+	"sync"
 
 	"github.com/pkg/errors"
 
@@ -18,6 +20,20 @@ func MapToHashMap[T1 any, T2 any, K comparable](source []T1, mappingFunc func(it
 	return result
 }
 
+// AssociateBy converts a list to a hashmap using key and value selectors.
+// When several items share a key, their values are combined with merge in list order.
+func AssociateBy[T any, K comparable, W any](source []T, keyFunc func(item T) K, valueFunc func(item T) W, merge func(old W, new W) W) map[K]W {
+	result := make(map[K]W)
+	for _, item := range source {
+		key, value := keyFunc(item), valueFunc(item)
+		if old, exists := result[key]; exists {
+			value = merge(old, value)
+		}
+		result[key] = value
+	}
+	return result
+}
+
 // MapToHashMapReturnWithError converts a list to a hashmap with error handling.
 func MapToHashMapReturnWithError[T1 any, T2 any, K comparable](source []T1, mappingFunc func(item T1) (K, T2, error)) (map[K]T2, error) {
 	result := make(map[K]T2)
@@ -40,6 +56,40 @@ func MapHashMapToHashMap[K comparable, V1 any, V2 any](source map[K]V1, mappingF
 	return result
 }
 
+// MapHashMapToHashMapParallel applies a transformation function to the entries of a hashmap concurrently
+// on the given number of workers and returns a new hashmap with the same keys. It suits expensive
+// transformations such as remote lookups; mappingFunc must be safe for concurrent use.
+// Workers below 1 are treated as 1.
+func MapHashMapToHashMapParallel[K comparable, V1 any, V2 any](source map[K]V1, workers int, mappingFunc func(key K, value V1) V2) map[K]V2 {
+	workers = max(min(workers, len(source)), 1)
+	type entry struct {
+		key   K
+		value V1
+	}
+	entries := make(chan entry)
+	result := make(map[K]V2, len(source))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for worker := 0; worker < workers; worker++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for e := range entries {
+				mapped := mappingFunc(e.key, e.value)
+				mu.Lock()
+				result[e.key] = mapped
+				mu.Unlock()
+			}
+		}()
+	}
+	for key, value := range source {
+		entries <- entry{key: key, value: value}
+	}
+	close(entries)
+	wg.Wait()
+	return result
+}
+
 // MapHashMapToHashMapReturnWithError applies a transformation function to a hashmap and handles errors.
 func MapHashMapToHashMapReturnWithError[K comparable, V1 any, V2 any](source map[K]V1, mappingFunc func(key K, value V1) (V2, error)) (map[K]V2, error) {
 	result := make(map[K]V2)
@@ -55,23 +105,13 @@ func MapHashMapToHashMapReturnWithError[K comparable, V1 any, V2 any](source map
 
 // MapHashMapToList applies a transformation function to a hashmap and returns a list.
 func MapHashMapToList[K comparable, V1 any, V2 any](source map[K]V1, mappingFunc func(key K, value V1) V2) []V2 {
-	keys := []K{}
-
-	for key := range source {
-		keys = append(keys, key)
-	}
-	sortedKeys := collection.Sort(keys, func(i, j int) bool { return fmt.Sprintf("%v", keys[i]) < fmt.Sprintf("%v", keys[j]) })
+	sortedKeys := SortedKeysBy(source, lessByString[K])
 	return collection.Map(sortedKeys, func(key K) V2 { return mappingFunc(key, source[key]) })
 }
 
 // MapHashMapToListReturnWithError applies a transformation function to a hashmap, returning a list with error handling.
 func MapHashMapToListReturnWithError[K comparable, V1 any, V2 any](source map[K]V1, mappingFunc func(key K, value V1) (V2, error)) ([]V2, error) {
-	keys := []K{}
-
-	for key := range source {
-		keys = append(keys, key)
-	}
-	sortedKeys := collection.Sort(keys, func(i, j int) bool { return fmt.Sprintf("%v", keys[i]) < fmt.Sprintf("%v", keys[j]) })
+	sortedKeys := SortedKeysBy(source, lessByString[K])
 	result := []V2{}
 	for _, key := range sortedKeys {
 		res, err := mappingFunc(key, source[key])
@@ -83,6 +123,104 @@ func MapHashMapToListReturnWithError[K comparable, V1 any, V2 any](source map[K]
 	return result, nil
 }
 
+// SortedKeysBy returns the keys of a hashmap sorted with the given less function,
+// so the map can be iterated deterministically.
+func SortedKeysBy[K comparable, V any](source map[K]V, less func(a, b K) bool) []K {
+	keys := make([]K, 0, len(source))
+	for key := range source {
+		keys = append(keys, key)
+	}
+	return collection.Sort(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+}
+
+// ForEachMapOrderedUntil calls action for the entries of a hashmap in the order given by less until
+// action returns false. Keys are kept in a heap rather than fully sorted up front, so stopping after
+// k entries costs O(n + k log n) instead of O(n log n).
+func ForEachMapOrderedUntil[K comparable, V any](source map[K]V, less func(a, b K) bool, action func(key K, value V) bool) {
+	keys := &keyHeap[K]{less: less, keys: make([]K, 0, len(source))}
+	for key := range source {
+		keys.keys = append(keys.keys, key)
+	}
+	heap.Init(keys)
+	for keys.Len() > 0 {
+		key := heap.Pop(keys).(K)
+		if !action(key, source[key]) {
+			return
+		}
+	}
+}
+
+// keyHeap is a min-heap of keys ordered by less.
+type keyHeap[K any] struct {
+	keys []K
+	less func(a, b K) bool
+}
+
+func (h *keyHeap[K]) Len() int           { return len(h.keys) }
+func (h *keyHeap[K]) Less(i, j int) bool { return h.less(h.keys[i], h.keys[j]) }
+func (h *keyHeap[K]) Swap(i, j int)      { h.keys[i], h.keys[j] = h.keys[j], h.keys[i] }
+func (h *keyHeap[K]) Push(x any)         { h.keys = append(h.keys, x.(K)) }
+func (h *keyHeap[K]) Pop() any {
+	last := h.keys[len(h.keys)-1]
+	h.keys = h.keys[:len(h.keys)-1]
+	return last
+}
+
+// Pair is a key/value entry of a hashmap.
+type Pair[K comparable, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
+
+// TopKByValue returns the k entries with the largest values according to less, largest first.
+// Entries with equal values are ordered by the string form of their keys, so the result is deterministic.
+// All entries are returned when the hashmap has fewer than k.
+func TopKByValue[K comparable, V any](source map[K]V, k int, less func(a, b V) bool) []Pair[K, V] {
+	result := make([]Pair[K, V], 0, max(min(k, len(source)), 0))
+	if k <= 0 {
+		return result
+	}
+	before := func(a, b K) bool {
+		if less(source[b], source[a]) {
+			return true
+		}
+		return !less(source[a], source[b]) && lessByString(a, b)
+	}
+	ForEachMapOrderedUntil(source, before, func(key K, value V) bool {
+		result = append(result, Pair[K, V]{Key: key, Value: value})
+		return len(result) < k
+	})
+	return result
+}
+
+// MaxByValue returns the entry with the largest value according to less, and false for an empty hashmap,
+// e.g. the most frequent key of a GroupCount result. On ties the key that sorts first as a string wins,
+// so the result does not depend on map iteration order.
+func MaxByValue[K comparable, V any](source map[K]V, less func(a, b V) bool) (K, V, bool) {
+	return extremeByValue(source, func(a, b V) bool { return less(b, a) })
+}
+
+// MinByValue returns the entry with the smallest value according to less, and false for an empty hashmap.
+// On ties the key that sorts first as a string wins.
+func MinByValue[K comparable, V any](source map[K]V, less func(a, b V) bool) (K, V, bool) {
+	return extremeByValue(source, less)
+}
+
+// extremeByValue returns the entry whose value comes first according to before.
+func extremeByValue[K comparable, V any](source map[K]V, before func(a, b V) bool) (bestKey K, bestValue V, found bool) {
+	for key, value := range source {
+		if !found || before(value, bestValue) || (!before(bestValue, value) && lessByString(key, bestKey)) {
+			bestKey, bestValue, found = key, value, true
+		}
+	}
+	return bestKey, bestValue, found
+}
+
+// lessByString orders keys by their string representation.
+func lessByString[K comparable](a, b K) bool {
+	return fmt.Sprintf("%v", a) < fmt.Sprintf("%v", b)
+}
+
 // SliceToHashMap converts a slice to a map with boolean values indicating presence.
 func SliceToHashMap[T comparable](list []T) map[T]bool {
 	result := make(map[T]bool)
@@ -91,3 +229,67 @@ func SliceToHashMap[T comparable](list []T) map[T]bool {
 	}
 	return result
 }
+
+// ConvertKeys converts the keys of a hashmap using a conversion function with error handling.
+// It fails when two source keys convert to the same target key.
+func ConvertKeys[K1 comparable, K2 comparable, V any](source map[K1]V, convertFunc func(key K1) (K2, error)) (map[K2]V, error) {
+	result := make(map[K2]V, len(source))
+	for key, value := range source {
+		converted, err := convertFunc(key)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("error converting key:'%v', error", key))
+		}
+		if _, exists := result[converted]; exists {
+			return nil, errors.Errorf("error converting key:'%v', converted key:'%v' is duplicated", key, converted)
+		}
+		result[converted] = value
+	}
+	return result, nil
+}
+
+// InvertMap swaps the keys and values of a hashmap. It fails when several keys share a value,
+// since the inverted map could only keep one of them; use InvertMapGrouped to keep them all.
+func InvertMap[K comparable, V comparable](source map[K]V) (map[V]K, error) {
+	grouped := InvertMapGrouped(source)
+	result := make(map[V]K, len(grouped))
+	for _, value := range SortedKeysBy(grouped, lessByString[V]) {
+		keys := grouped[value]
+		if len(keys) > 1 {
+			return nil, errors.Errorf("error inverting map, value:'%v' is shared by keys %v", value, keys)
+		}
+		result[value] = keys[0]
+	}
+	return result, nil
+}
+
+// InvertMapGrouped swaps the keys and values of a hashmap, collecting the keys sharing a value.
+// The keys of each group are ordered by their string representation.
+func InvertMapGrouped[K comparable, V comparable](source map[K]V) map[V][]K {
+	result := make(map[V][]K)
+	for _, key := range SortedKeysBy(source, lessByString[K]) {
+		value := source[key]
+		result[value] = append(result[value], key)
+	}
+	return result
+}
+
+// StringifyKeys converts the keys of a hashmap to strings, e.g. to prepare it for JSON encoding.
+func StringifyKeys[K comparable, V any](source map[K]V) (map[string]V, error) {
+	return ConvertKeys(source, func(key K) (string, error) { return fmt.Sprint(key), nil })
+}
+
+// MergeWithMonoid merges hashmaps into a new hashmap, combining the values of shared keys with the monoid
+// in the order the hashmaps are given.
+func MergeWithMonoid[K comparable, V any](sources []map[K]V, monoid collection.Monoid[V]) map[K]V {
+	result := make(map[K]V)
+	for _, source := range sources {
+		for key, value := range source {
+			acc, exists := result[key]
+			if !exists {
+				acc = monoid.Empty()
+			}
+			result[key] = monoid.Combine(acc, value)
+		}
+	}
+	return result
+}
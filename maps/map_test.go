@@ -3,9 +3,13 @@ package maps
 import (
 	"errors"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
 )
 
 func TestMapHashMapToHashMap(t *testing.T) {
@@ -48,6 +52,41 @@ func TestMapHashMapToHashMap(t *testing.T) {
 	})
 }
 
+func TestMapHashMapToHashMapParallel(t *testing.T) {
+	t.Run("Success_preserves_keys", func(t *testing.T) {
+		source := map[string]int{}
+		for i := 0; i < 100; i++ {
+			source["k"+strconv.Itoa(i)] = i
+		}
+		var running, peak int32
+
+		result := MapHashMapToHashMapParallel(source, 4, func(key string, value int) string {
+			current := atomic.AddInt32(&running, 1)
+			for {
+				observed := atomic.LoadInt32(&peak)
+				if current <= observed || atomic.CompareAndSwapInt32(&peak, observed, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			return key + "=" + strconv.Itoa(value)
+		})
+
+		assert.Len(t, result, 100)
+		assert.Equal(t, "k42=42", result["k42"])
+		assert.LessOrEqual(t, peak, int32(4))
+		assert.Greater(t, peak, int32(1))
+	})
+
+	t.Run("Success_empty_and_invalid_workers", func(t *testing.T) {
+		double := func(key string, value int) int { return value * 2 }
+
+		assert.Equal(t, map[string]int{}, MapHashMapToHashMapParallel(map[string]int{}, 4, double))
+		assert.Equal(t, map[string]int{"a": 2}, MapHashMapToHashMapParallel(map[string]int{"a": 1}, 0, double))
+	})
+}
+
 func TestMapHashMapToHashMapWithError(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 
@@ -396,3 +435,242 @@ func TestMapToHashMapReturnWithError(t *testing.T) {
 	})
 
 }
+
+func TestConvertKeys(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		source := map[string]int{"1": 10, "2": 20}
+
+		result, err := ConvertKeys(source, strconv.Atoi)
+		assert.NoError(t, err)
+
+		assert.Equal(t, map[int]int{1: 10, 2: 20}, result)
+	})
+
+	t.Run("Error_conversion", func(t *testing.T) {
+		source := map[string]int{"x": 10}
+
+		result, err := ConvertKeys(source, strconv.Atoi)
+
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "error converting key:'x'")
+	})
+
+	t.Run("Error_duplicate_key", func(t *testing.T) {
+		source := map[string]int{"1": 10, "01": 20}
+
+		result, err := ConvertKeys(source, strconv.Atoi)
+
+		assert.Nil(t, result)
+		assert.ErrorContains(t, err, "converted key:'1' is duplicated")
+	})
+}
+
+func TestStringifyKeys(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		source := map[int]string{1: "a", 2: "b"}
+
+		result, err := StringifyKeys(source)
+		assert.NoError(t, err)
+
+		assert.Equal(t, map[string]string{"1": "a", "2": "b"}, result)
+	})
+
+	t.Run("Success_empty_map", func(t *testing.T) {
+		result, err := StringifyKeys(map[float64]int{})
+		assert.NoError(t, err)
+
+		assert.Equal(t, map[string]int{}, result)
+	})
+}
+
+func TestSortedKeysBy(t *testing.T) {
+	t.Run("Success_ascending", func(t *testing.T) {
+		source := map[int]string{3: "c", 1: "a", 2: "b"}
+
+		result := SortedKeysBy(source, func(a, b int) bool { return a < b })
+
+		assert.Equal(t, []int{1, 2, 3}, result)
+	})
+
+	t.Run("Success_descending", func(t *testing.T) {
+		source := map[string]int{"apple": 1, "cherry": 3, "banana": 2}
+
+		result := SortedKeysBy(source, func(a, b string) bool { return a > b })
+
+		assert.Equal(t, []string{"cherry", "banana", "apple"}, result)
+	})
+
+	t.Run("Success_empty_map", func(t *testing.T) {
+		result := SortedKeysBy(map[int]int{}, func(a, b int) bool { return a < b })
+
+		assert.Equal(t, []int{}, result)
+	})
+}
+
+func TestMergeWithMonoid(t *testing.T) {
+	t.Run("Success_sum_counts", func(t *testing.T) {
+		shards := []map[string]int{
+			{"apple": 1, "banana": 2},
+			{"banana": 3, "cherry": 4},
+			{"apple": 5},
+		}
+
+		result := MergeWithMonoid(shards, collection.SumMonoid[int]())
+
+		assert.Equal(t, map[string]int{"apple": 6, "banana": 5, "cherry": 4}, result)
+	})
+
+	t.Run("Success_ordered_concat", func(t *testing.T) {
+		shards := []map[int][]string{
+			{1: {"a"}},
+			{1: {"b"}, 2: {"c"}},
+		}
+
+		result := MergeWithMonoid(shards, collection.AppendMonoid[string]())
+
+		assert.Equal(t, map[int][]string{1: {"a", "b"}, 2: {"c"}}, result)
+	})
+
+	t.Run("Success_no_maps", func(t *testing.T) {
+		result := MergeWithMonoid[string](nil, collection.SumMonoid[int]())
+
+		assert.Equal(t, map[string]int{}, result)
+	})
+}
+
+func TestAssociateBy(t *testing.T) {
+	type Order struct {
+		Customer string
+		Amount   int
+	}
+	orders := []Order{
+		{Customer: "C1", Amount: 100},
+		{Customer: "C2", Amount: 50},
+		{Customer: "C1", Amount: 25},
+	}
+
+	t.Run("Success_merge_sum", func(t *testing.T) {
+		result := AssociateBy(orders,
+			func(o Order) string { return o.Customer },
+			func(o Order) int { return o.Amount },
+			func(old, new int) int { return old + new })
+
+		assert.Equal(t, map[string]int{"C1": 125, "C2": 50}, result)
+	})
+
+	t.Run("Success_keep_first", func(t *testing.T) {
+		result := AssociateBy(orders,
+			func(o Order) string { return o.Customer },
+			func(o Order) Order { return o },
+			func(old, new Order) Order { return old })
+
+		assert.Equal(t, map[string]Order{"C1": orders[0], "C2": orders[1]}, result)
+	})
+
+	t.Run("Success_empty_list", func(t *testing.T) {
+		result := AssociateBy([]Order{},
+			func(o Order) string { return o.Customer },
+			func(o Order) int { return o.Amount },
+			func(old, new int) int { return new })
+
+		assert.Equal(t, map[string]int{}, result)
+	})
+}
+
+func TestInvertMap(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result, err := InvertMap(map[string]int{"one": 1, "two": 2})
+
+		assert.NoError(t, err)
+		assert.Equal(t, map[int]string{1: "one", 2: "two"}, result)
+	})
+
+	t.Run("Error_duplicate_values", func(t *testing.T) {
+		result, err := InvertMap(map[string]string{"TH": "Asia", "JP": "Asia", "FR": "Europe"})
+
+		assert.Nil(t, result)
+		assert.EqualError(t, err, "error inverting map, value:'Asia' is shared by keys [JP TH]")
+	})
+}
+
+func TestInvertMapGrouped(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		result := InvertMapGrouped(map[string]string{"TH": "Asia", "JP": "Asia", "FR": "Europe"})
+
+		assert.Equal(t, map[string][]string{"Asia": {"JP", "TH"}, "Europe": {"FR"}}, result)
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		assert.Equal(t, map[int][]string{}, InvertMapGrouped(map[string]int{}))
+	})
+}
+
+func TestForEachMapOrderedUntil(t *testing.T) {
+	stock := map[string]int{"pear": 0, "apple": 3, "fig": 0, "banana": 5, "cherry": 1}
+	byName := func(a, b string) bool { return a < b }
+
+	t.Run("Success_stops_early", func(t *testing.T) {
+		visited := []string{}
+
+		ForEachMapOrderedUntil(stock, byName, func(key string, value int) bool {
+			visited = append(visited, key)
+			return value > 0
+		})
+
+		assert.Equal(t, []string{"apple", "banana", "cherry", "fig"}, visited)
+	})
+
+	t.Run("Success_visits_all_in_order", func(t *testing.T) {
+		visited := []string{}
+
+		ForEachMapOrderedUntil(stock, func(a, b string) bool { return a > b }, func(key string, value int) bool {
+			visited = append(visited, key)
+			return true
+		})
+
+		assert.Equal(t, []string{"pear", "fig", "cherry", "banana", "apple"}, visited)
+	})
+}
+
+func TestMaxMinByValue(t *testing.T) {
+	counts := map[string]int{"red": 3, "blue": 7, "green": 7, "black": 1, "white": 1}
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Success_max_with_tie", func(t *testing.T) {
+		key, value, ok := MaxByValue(counts, less)
+
+		assert.True(t, ok)
+		assert.Equal(t, "blue", key)
+		assert.Equal(t, 7, value)
+	})
+
+	t.Run("Success_min_with_tie", func(t *testing.T) {
+		key, value, ok := MinByValue(counts, less)
+
+		assert.True(t, ok)
+		assert.Equal(t, "black", key)
+		assert.Equal(t, 1, value)
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		_, _, ok := MaxByValue(map[string]int{}, less)
+
+		assert.False(t, ok)
+	})
+}
+
+func TestTopKByValue(t *testing.T) {
+	counts := map[string]int{"red": 3, "blue": 7, "green": 7, "black": 1, "white": 5}
+	less := func(a, b int) bool { return a < b }
+
+	t.Run("Success_top_three", func(t *testing.T) {
+		result := TopKByValue(counts, 3, less)
+
+		assert.Equal(t, []Pair[string, int]{{"blue", 7}, {"green", 7}, {"white", 5}}, result)
+	})
+
+	t.Run("Success_k_larger_than_map", func(t *testing.T) {
+		assert.Len(t, TopKByValue(counts, 10, less), 5)
+		assert.Equal(t, []Pair[string, int]{}, TopKByValue(counts, 0, less))
+	})
+}
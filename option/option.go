@@ -0,0 +1,126 @@
+// Package option provides Option[T], a value that may be absent, as an alternative to nil checks
+// when composing the higher-order functions of this module.
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Option holds either a value (Some) or nothing (None). The zero value is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Option holding the value.
+func Some[T any](value T) Option[T] {
+	return Option[T]{value: value, ok: true}
+}
+
+// None returns an empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromPtr returns Some of the pointed-to value, or None for a nil pointer.
+func FromPtr[T any](ptr *T) Option[T] {
+	if ptr == nil {
+		return None[T]()
+	}
+	return Some(*ptr)
+}
+
+// FromPair converts a (value, ok) pair, as returned by map lookups and type assertions.
+func FromPair[T any](value T, ok bool) Option[T] {
+	if !ok {
+		return None[T]()
+	}
+	return Some(value)
+}
+
+// IsSome reports whether the Option holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone reports whether the Option is empty.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and whether it is present.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// GetOrElse returns the value, or fallback when the Option is empty.
+func (o Option[T]) GetOrElse(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.value
+}
+
+// OrElse returns the Option itself when it holds a value, otherwise the alternative.
+func (o Option[T]) OrElse(alternative Option[T]) Option[T] {
+	if !o.ok {
+		return alternative
+	}
+	return o
+}
+
+// Filter keeps the value only if it satisfies the predicate.
+func (o Option[T]) Filter(predicate func(value T) bool) Option[T] {
+	if !o.ok || !predicate(o.value) {
+		return None[T]()
+	}
+	return o
+}
+
+// Ptr returns a pointer to a copy of the value, or nil when the Option is empty.
+func (o Option[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+	value := o.value
+	return &value
+}
+
+// MarshalJSON encodes the value, or null when the Option is empty.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if !o.ok {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as None and anything else as Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
+
+// Map applies the transformation to the value, if any.
+func Map[T1 any, T2 any](o Option[T1], transform func(value T1) T2) Option[T2] {
+	if !o.ok {
+		return None[T2]()
+	}
+	return Some(transform(o.value))
+}
+
+// FlatMap applies a transformation that itself may produce no value.
+func FlatMap[T1 any, T2 any](o Option[T1], transform func(value T1) Option[T2]) Option[T2] {
+	if !o.ok {
+		return None[T2]()
+	}
+	return transform(o.value)
+}
@@ -0,0 +1,70 @@
+package option
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOption(t *testing.T) {
+	t.Run("Success_some_and_none", func(t *testing.T) {
+		some := Some(5)
+		none := None[int]()
+
+		value, ok := some.Get()
+		assert.True(t, ok)
+		assert.Equal(t, 5, value)
+		assert.True(t, some.IsSome())
+		assert.True(t, none.IsNone())
+		assert.Equal(t, none, Option[int]{})
+		assert.Equal(t, 5, some.GetOrElse(9))
+		assert.Equal(t, 9, none.GetOrElse(9))
+		assert.Equal(t, some, none.OrElse(some))
+		assert.Equal(t, some, some.OrElse(Some(1)))
+	})
+
+	t.Run("Success_conversions", func(t *testing.T) {
+		name := "alice"
+		ages := map[string]int{"alice": 30}
+
+		assert.Equal(t, Some("alice"), FromPtr(&name))
+		assert.Equal(t, None[string](), FromPtr[string](nil))
+		assert.Equal(t, Some(30), FromPair(ages["alice"], true))
+		age, ok := ages["bob"]
+		assert.Equal(t, None[int](), FromPair(age, ok))
+		assert.Equal(t, "alice", *Some("alice").Ptr())
+		assert.Nil(t, None[string]().Ptr())
+	})
+
+	t.Run("Success_map_flatMap_filter", func(t *testing.T) {
+		parse := func(s string) Option[int] {
+			n, err := strconv.Atoi(s)
+			return FromPair(n, err == nil)
+		}
+		positive := func(n int) bool { return n > 0 }
+
+		assert.Equal(t, Some("42!"), Map(Some(42), func(n int) string { return strconv.Itoa(n) + "!" }))
+		assert.Equal(t, None[string](), Map(None[int](), strconv.Itoa))
+		assert.Equal(t, Some(12), FlatMap(Some("12"), parse))
+		assert.Equal(t, None[int](), FlatMap(Some("x"), parse))
+		assert.Equal(t, Some(3), Some(3).Filter(positive))
+		assert.Equal(t, None[int](), Some(-3).Filter(positive))
+	})
+
+	t.Run("Success_json", func(t *testing.T) {
+		type Profile struct {
+			Nickname Option[string] `json:"nickname"`
+			Age      Option[int]    `json:"age"`
+		}
+
+		encoded, err := json.Marshal(Profile{Nickname: Some("ali")})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"nickname":"ali","age":null}`, string(encoded))
+
+		var decoded Profile
+		assert.NoError(t, json.Unmarshal([]byte(`{"nickname":null,"age":31}`), &decoded))
+		assert.Equal(t, Profile{Age: Some(31)}, decoded)
+	})
+}
@@ -0,0 +1,100 @@
+package pipeline
+
+import (
+	"fmt"
+	"sync"
+)
+
+// CheckpointStore persists the index of the last item a pipeline run has fully processed,
+// so that a restarted run can skip the work already done.
+type CheckpointStore interface {
+	// Load returns the last saved index, or ok false when no checkpoint exists yet.
+	Load() (index int, ok bool, err error)
+	// Save records index as the last fully processed item.
+	Save(index int) error
+}
+
+// MemoryCheckpointStore is an in-memory CheckpointStore, useful for tests and single-process retries.
+type MemoryCheckpointStore struct {
+	mu    sync.Mutex
+	index int
+	saved bool
+}
+
+// Load returns the last saved index.
+func (s *MemoryCheckpointStore) Load() (int, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.index, s.saved, nil
+}
+
+// Save records the index.
+func (s *MemoryCheckpointStore) Save(index int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.index, s.saved = index, true
+	return nil
+}
+
+// ResumeFrom drops the results whose input index is at or before the checkpoint in the store.
+// Without a checkpoint all results are returned.
+func ResumeFrom[T any](results []Result[T], store CheckpointStore) ([]Result[T], error) {
+	last, ok, err := store.Load()
+	if err != nil {
+		return nil, fmt.Errorf("error loading checkpoint, error: %w", err)
+	}
+	if !ok {
+		return results, nil
+	}
+	remaining := make([]Result[T], 0, len(results))
+	for _, result := range results {
+		if result.Index > last {
+			remaining = append(remaining, result)
+		}
+	}
+	return remaining, nil
+}
+
+// ForEachCheckpointed resumes from the store's checkpoint and calls handle for every remaining
+// successful result in order, saving the index of the last handled item after every `every` items
+// and once more at the end. Failed results are skipped; collect them before running the sink.
+// When handle fails, the progress made so far is saved and the error is returned, so the next run
+// restarts at the failing item.
+func ForEachCheckpointed[T any](results []Result[T], store CheckpointStore, every int, handle func(item T) error) error {
+	if every < 1 {
+		every = 1
+	}
+	remaining, err := ResumeFrom(results, store)
+	if err != nil {
+		return err
+	}
+	last, pending := -1, 0
+	save := func() error {
+		if pending == 0 {
+			return nil
+		}
+		pending = 0
+		if err := store.Save(last); err != nil {
+			return fmt.Errorf("error saving checkpoint at index:'%v', error: %w", last, err)
+		}
+		return nil
+	}
+	for _, result := range remaining {
+		if result.Ok() {
+			if err := handle(result.Value); err != nil {
+				if saveErr := save(); saveErr != nil {
+					return saveErr
+				}
+				return fmt.Errorf("error processing at index:'%v', error: %w", result.Index, err)
+			}
+		}
+		last = result.Index
+		pending++
+		if pending >= every {
+			if err := save(); err != nil {
+				return err
+			}
+		}
+	}
+	return save()
+}
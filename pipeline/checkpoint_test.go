@@ -0,0 +1,97 @@
+package pipeline
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type failingStore struct {
+	MemoryCheckpointStore
+	err error
+}
+
+func (s *failingStore) Load() (int, bool, error) {
+	return 0, false, s.err
+}
+
+func TestResumeFrom(t *testing.T) {
+	results := FromSlice([]string{"a", "b", "c", "d"})
+
+	t.Run("Success_without_checkpoint", func(t *testing.T) {
+		remaining, err := ResumeFrom(results, &MemoryCheckpointStore{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, results, remaining)
+	})
+
+	t.Run("Success_skips_processed", func(t *testing.T) {
+		store := &MemoryCheckpointStore{}
+		assert.NoError(t, store.Save(1))
+
+		remaining, err := ResumeFrom(results, store)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Result[string]{{Value: "c", Index: 2}, {Value: "d", Index: 3}}, remaining)
+	})
+
+	t.Run("Error_loading", func(t *testing.T) {
+		_, err := ResumeFrom(results, &failingStore{err: errors.New("disk gone")})
+
+		assert.EqualError(t, err, "error loading checkpoint, error: disk gone")
+	})
+}
+
+func TestForEachCheckpointed(t *testing.T) {
+	t.Run("Success_crash_and_resume", func(t *testing.T) {
+		errCrash := errors.New("crash")
+		store := &MemoryCheckpointStore{}
+		results := FromSlice([]int{1, 2, 3, 4, 5, 6})
+		handled := []int{}
+		crashed := false
+
+		handle := func(item int) error {
+			if item == 5 && !crashed {
+				crashed = true
+				return errCrash
+			}
+			handled = append(handled, item)
+			return nil
+		}
+
+		err := ForEachCheckpointed(results, store, 2, handle)
+		assert.ErrorIs(t, err, errCrash)
+		assert.EqualError(t, err, "error processing at index:'4', error: crash")
+		index, ok, _ := store.Load()
+		assert.True(t, ok)
+		assert.Equal(t, 3, index)
+
+		err = ForEachCheckpointed(results, store, 2, handle)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, handled)
+		index, _, _ = store.Load()
+		assert.Equal(t, 5, index)
+	})
+
+	t.Run("Success_skips_failed_results", func(t *testing.T) {
+		store := &MemoryCheckpointStore{}
+		results := MapE(FromSlice([]int{1, -2, 3}), "validate", func(item int) (int, error) {
+			if item < 0 {
+				return 0, errors.New("negative")
+			}
+			return item, nil
+		})
+		handled := []int{}
+
+		err := ForEachCheckpointed(results, store, 10, func(item int) error {
+			handled = append(handled, item)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 3}, handled)
+		index, _, _ := store.Load()
+		assert.Equal(t, 2, index)
+	})
+}
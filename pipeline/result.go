@@ -0,0 +1,108 @@
+package pipeline
+
+import "fmt"
+
+// StageError records an item-level failure together with the stage that produced it
+// and the index of the item in the original input.
+type StageError struct {
+	Stage string
+	Index int
+	Err   error
+}
+
+// Error renders the failure with its origin stage and index.
+func (e *StageError) Error() string {
+	return fmt.Sprintf("error at stage:'%s', index:'%v', error: %v", e.Stage, e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+// Result carries an item through the stages of a pipeline. A failed result keeps its StageError and
+// is passed through later stages untouched, so the failure can be collected at the end.
+type Result[T any] struct {
+	Value T
+	Index int
+	Err   *StageError
+}
+
+// Ok reports whether the item has not failed in any stage.
+func (r Result[T]) Ok() bool {
+	return r.Err == nil
+}
+
+// FromSlice wraps each item of the list into a successful Result remembering its index.
+func FromSlice[T any](source []T) []Result[T] {
+	results := make([]Result[T], len(source))
+	for idx, item := range source {
+		results[idx] = Result[T]{Value: item, Index: idx}
+	}
+	return results
+}
+
+// MapE applies a fallible transformation to every successful result. Items for which the function
+// returns an error are marked failed at the given stage; already failed items are carried over as is.
+func MapE[T1 any, T2 any](results []Result[T1], stage string, mappingFunc func(item T1) (T2, error)) []Result[T2] {
+	mapped := make([]Result[T2], 0, len(results))
+	for _, result := range results {
+		if !result.Ok() {
+			mapped = append(mapped, Result[T2]{Index: result.Index, Err: result.Err})
+			continue
+		}
+		value, err := mappingFunc(result.Value)
+		if err != nil {
+			mapped = append(mapped, Result[T2]{Index: result.Index, Err: &StageError{Stage: stage, Index: result.Index, Err: err}})
+			continue
+		}
+		mapped = append(mapped, Result[T2]{Value: value, Index: result.Index})
+	}
+	return mapped
+}
+
+// FilterE keeps the successful results accepted by a fallible predicate. Items for which the predicate
+// returns an error are marked failed at the given stage; failed items are always kept so they can be collected.
+func FilterE[T any](results []Result[T], stage string, filterFunc func(item T) (bool, error)) []Result[T] {
+	filtered := make([]Result[T], 0, len(results))
+	for _, result := range results {
+		if !result.Ok() {
+			filtered = append(filtered, result)
+			continue
+		}
+		keep, err := filterFunc(result.Value)
+		if err != nil {
+			filtered = append(filtered, Result[T]{Index: result.Index, Err: &StageError{Stage: stage, Index: result.Index, Err: err}})
+			continue
+		}
+		if keep {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered
+}
+
+// Collect splits the results into the values of successful items and the failures, both in input order.
+func Collect[T any](results []Result[T]) ([]T, []*StageError) {
+	values := []T{}
+	failures := []*StageError{}
+	for _, result := range results {
+		if result.Ok() {
+			values = append(values, result.Value)
+		} else {
+			failures = append(failures, result.Err)
+		}
+	}
+	return values, failures
+}
+
+// PartitionResults splits the results into the values of successful items and their errors,
+// both in input order, for post-processing with the plain error helpers.
+func PartitionResults[T any](results []Result[T]) ([]T, []error) {
+	values, failures := Collect(results)
+	errs := make([]error, len(failures))
+	for idx, failure := range failures {
+		errs[idx] = failure
+	}
+	return values, errs
+}
@@ -0,0 +1,93 @@
+package pipeline
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineE(t *testing.T) {
+	t.Run("Success_all_items", func(t *testing.T) {
+		parsed := MapE(FromSlice([]string{"1", "2", "3"}), "parse", strconv.Atoi)
+		doubled := MapE(parsed, "double", func(item int) (int, error) { return item * 2, nil })
+
+		values, failures := Collect(doubled)
+
+		assert.Equal(t, []int{2, 4, 6}, values)
+		assert.Empty(t, failures)
+	})
+
+	t.Run("Error_items_carried_through_later_stages", func(t *testing.T) {
+		errNegative := errors.New("negative value")
+		calls := 0
+
+		parsed := MapE(FromSlice([]string{"1", "x", "-3", "4"}), "parse", strconv.Atoi)
+		validated := MapE(parsed, "validate", func(item int) (int, error) {
+			calls++
+			if item < 0 {
+				return 0, errNegative
+			}
+			return item, nil
+		})
+		kept := FilterE(validated, "even", func(item int) (bool, error) { return item%2 == 0, nil })
+
+		values, failures := Collect(kept)
+
+		assert.Equal(t, []int{4}, values)
+		assert.Equal(t, 3, calls)
+		assert.Len(t, failures, 2)
+
+		assert.Equal(t, "parse", failures[0].Stage)
+		assert.Equal(t, 1, failures[0].Index)
+		assert.Equal(t, "validate", failures[1].Stage)
+		assert.Equal(t, 2, failures[1].Index)
+		assert.ErrorIs(t, failures[1], errNegative)
+		assert.Equal(t, "error at stage:'validate', index:'2', error: negative value", failures[1].Error())
+	})
+
+	t.Run("Error_filter_predicate", func(t *testing.T) {
+		filtered := FilterE(FromSlice([]int{1, 2}), "lookup", func(item int) (bool, error) {
+			if item == 2 {
+				return false, errors.New("lookup failed")
+			}
+			return true, nil
+		})
+
+		values, failures := Collect(filtered)
+
+		assert.Equal(t, []int{1}, values)
+		assert.Equal(t, "error at stage:'lookup', index:'1', error: lookup failed", failures[0].Error())
+	})
+
+	t.Run("Success_empty_input", func(t *testing.T) {
+		values, failures := Collect(MapE(FromSlice([]int{}), "noop", func(item int) (int, error) { return item, nil }))
+
+		assert.Equal(t, []int{}, values)
+		assert.Empty(t, failures)
+	})
+}
+
+func TestPartitionResults(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		results := MapE(FromSlice([]string{"1", "a", "3"}), "parse", strconv.Atoi)
+
+		values, errs := PartitionResults(results)
+
+		assert.Equal(t, []int{1, 3}, values)
+		assert.Len(t, errs, 1)
+		assert.ErrorIs(t, errs[0], strconv.ErrSyntax)
+
+		var stageErr *StageError
+		assert.True(t, errors.As(errs[0], &stageErr))
+		assert.Equal(t, 1, stageErr.Index)
+	})
+
+	t.Run("Success_no_failures", func(t *testing.T) {
+		values, errs := PartitionResults(FromSlice([]int{1}))
+
+		assert.Equal(t, []int{1}, values)
+		assert.Equal(t, []error{}, errs)
+	})
+}
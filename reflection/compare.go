@@ -0,0 +1,25 @@
+package reflection
+
+import "reflect"
+
+// EqualIgnoring reports whether two values are deeply equal once the fields at the given
+// dot-separated paths are ignored, e.g. volatile timestamps or generated IDs.
+// Paths may traverse pointers and fan out over slices ("Items.UpdatedAt").
+func EqualIgnoring[T any](a, b T, paths ...string) bool {
+	left := DeepCopy(a)
+	right := DeepCopy(b)
+	for _, path := range paths {
+		zeroPath(reflect.ValueOf(&left).Elem(), path)
+		zeroPath(reflect.ValueOf(&right).Elem(), path)
+	}
+	return reflect.DeepEqual(left, right)
+}
+
+func zeroPath(element reflect.Value, path string) {
+	_, _ = visitPath(element, path, func(field reflect.Value) error {
+		if field.CanSet() {
+			field.Set(reflect.Zero(field.Type()))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,147 @@
+package reflection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type compareLine struct {
+	SKU       string
+	UpdatedAt time.Time
+}
+
+type compareOrder struct {
+	ID        string
+	Customer  string
+	CreatedAt time.Time
+	Lines     []compareLine
+	Audit     *compareAudit
+}
+
+type compareAudit struct {
+	By string
+	At time.Time
+}
+
+func TestEqualIgnoring(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+
+	a := compareOrder{
+		ID: "1", Customer: "C1", CreatedAt: now,
+		Lines: []compareLine{{SKU: "A", UpdatedAt: now}, {SKU: "B", UpdatedAt: now}},
+		Audit: &compareAudit{By: "alice", At: now},
+	}
+	b := compareOrder{
+		ID: "2", Customer: "C1", CreatedAt: later,
+		Lines: []compareLine{{SKU: "A", UpdatedAt: later}, {SKU: "B", UpdatedAt: later}},
+		Audit: &compareAudit{By: "alice", At: later},
+	}
+
+	t.Run("Equal_when_volatile_fields_ignored", func(t *testing.T) {
+		assert.True(t, EqualIgnoring(a, b, "ID", "CreatedAt", "Lines.UpdatedAt", "Audit.At"))
+	})
+
+	t.Run("Not_equal_when_some_volatile_field_remains", func(t *testing.T) {
+		assert.False(t, EqualIgnoring(a, b, "ID", "CreatedAt", "Lines.UpdatedAt"))
+		assert.False(t, EqualIgnoring(a, b))
+	})
+
+	t.Run("Not_equal_on_meaningful_difference", func(t *testing.T) {
+		c := b
+		c.Customer = "C2"
+
+		assert.False(t, EqualIgnoring(a, c, "ID", "CreatedAt", "Lines.UpdatedAt", "Audit.At"))
+	})
+
+	t.Run("Originals_untouched", func(t *testing.T) {
+		EqualIgnoring(a, b, "ID", "Lines.UpdatedAt", "Audit.At")
+
+		assert.Equal(t, "1", a.ID)
+		assert.Equal(t, now, a.Lines[0].UpdatedAt)
+		assert.Equal(t, now, a.Audit.At)
+	})
+
+	t.Run("Unknown_paths_are_ignored", func(t *testing.T) {
+		assert.True(t, EqualIgnoring(a, a, "Nonexistent", "Customer.Name"))
+	})
+
+	t.Run("Pointer_values", func(t *testing.T) {
+		assert.True(t, EqualIgnoring(&a, &b, "ID", "CreatedAt", "Lines.UpdatedAt", "Audit.At"))
+	})
+}
+
+func TestDeepCopy(t *testing.T) {
+	type node struct {
+		Name     string
+		Tags     []string
+		Attrs    map[string]int
+		Children []*node
+		Any      interface{}
+	}
+
+	original := &node{
+		Name:     "root",
+		Tags:     []string{"a"},
+		Attrs:    map[string]int{"x": 1},
+		Children: []*node{{Name: "child"}},
+		Any:      []int{1},
+	}
+
+	copied := DeepCopy(original)
+	copied.Tags[0] = "changed"
+	copied.Attrs["x"] = 2
+	copied.Children[0].Name = "changed"
+	copied.Any.([]int)[0] = 2
+
+	assert.Equal(t, "a", original.Tags[0])
+	assert.Equal(t, 1, original.Attrs["x"])
+	assert.Equal(t, "child", original.Children[0].Name)
+	assert.Equal(t, []int{1}, original.Any)
+
+	t.Run("Cyclic_pointers", func(t *testing.T) {
+		cyclic := &node{Name: "self"}
+		cyclic.Children = []*node{cyclic}
+
+		copiedCyclic := DeepCopy(cyclic)
+
+		assert.Same(t, copiedCyclic, copiedCyclic.Children[0])
+		assert.NotSame(t, cyclic, copiedCyclic)
+	})
+
+	t.Run("Pointer_to_first_field", func(t *testing.T) {
+		type inner struct {
+			X int
+		}
+		type outer struct {
+			A *inner
+			B *int
+		}
+		in := &inner{X: 1}
+
+		copiedOuter := DeepCopy(outer{A: in, B: &in.X})
+
+		assert.Equal(t, 1, copiedOuter.A.X)
+		assert.Equal(t, 1, *copiedOuter.B)
+		assert.NotSame(t, in, copiedOuter.A)
+	})
+
+	t.Run("Cyclic_map_and_slice", func(t *testing.T) {
+		cyclicMap := map[string]any{"name": "m"}
+		cyclicMap["self"] = cyclicMap
+		cyclicSlice := []any{"s", nil}
+		cyclicSlice[1] = cyclicSlice
+
+		copiedMap := DeepCopy(cyclicMap)
+		copiedSlice := DeepCopy(cyclicSlice)
+
+		copiedMap["name"] = "changed"
+		assert.Equal(t, "changed", copiedMap["self"].(map[string]any)["name"])
+		assert.Equal(t, "m", cyclicMap["name"])
+		copiedSlice[0] = "changed"
+		assert.Equal(t, "changed", copiedSlice[1].([]any)[0])
+		assert.Equal(t, "s", cyclicSlice[0])
+	})
+}
@@ -0,0 +1,151 @@
+package reflection
+
+import (
+	"reflect"
+	"strings"
+)
+
+// DeepCopy returns a copy of the value that shares no pointers, slices or maps with the original,
+// so the copy can be modified freely. Unexported fields are copied shallowly. Pointers, maps and
+// slices shared within the value stay shared within the copy, so cyclic values are copied as cycles.
+func DeepCopy[T any](value T) T {
+	source := reflect.ValueOf(&value).Elem()
+	return deepCopy(source, make(map[copyKey]reflect.Value)).Interface().(T)
+}
+
+// copyKey identifies a pointer, map or slice already copied. The type tells a struct from its first
+// field, which share an address, and the length tells a slice from its shorter reslices.
+type copyKey struct {
+	addr uintptr
+	typ  reflect.Type
+	len  int
+}
+
+func deepCopy(value reflect.Value, copied map[copyKey]reflect.Value) reflect.Value {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return value
+		}
+		key := copyKey{addr: value.Pointer(), typ: value.Type()}
+		if existing, ok := copied[key]; ok {
+			return existing
+		}
+		result := reflect.New(value.Type().Elem())
+		copied[key] = result
+		result.Elem().Set(deepCopy(value.Elem(), copied))
+		return result
+	case reflect.Struct:
+		result := reflect.New(value.Type()).Elem()
+		result.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			if result.Field(i).CanSet() {
+				result.Field(i).Set(deepCopy(value.Field(i), copied))
+			}
+		}
+		return result
+	case reflect.Slice:
+		if value.IsNil() {
+			return value
+		}
+		key := copyKey{addr: value.Pointer(), typ: value.Type(), len: value.Len()}
+		if existing, ok := copied[key]; ok && value.Len() > 0 {
+			return existing
+		}
+		result := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		copied[key] = result
+		for i := 0; i < value.Len(); i++ {
+			result.Index(i).Set(deepCopy(value.Index(i), copied))
+		}
+		return result
+	case reflect.Array:
+		result := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.Len(); i++ {
+			result.Index(i).Set(deepCopy(value.Index(i), copied))
+		}
+		return result
+	case reflect.Map:
+		if value.IsNil() {
+			return value
+		}
+		key := copyKey{addr: value.Pointer(), typ: value.Type()}
+		if existing, ok := copied[key]; ok {
+			return existing
+		}
+		result := reflect.MakeMapWithSize(value.Type(), value.Len())
+		copied[key] = result
+		iter := value.MapRange()
+		for iter.Next() {
+			result.SetMapIndex(iter.Key(), deepCopy(iter.Value(), copied))
+		}
+		return result
+	case reflect.Interface:
+		if value.IsNil() {
+			return value
+		}
+		result := reflect.New(value.Type()).Elem()
+		result.Set(deepCopy(value.Elem(), copied))
+		return result
+	}
+	return value
+}
+
+// visitPath calls visit on every value reached by the dot-separated path. Pointers are followed
+// (nil pointers end the walk) and slices and arrays fan out to each of their elements.
+// It reports whether the path matched a field.
+func visitPath(element reflect.Value, fieldName string, visit func(field reflect.Value) error) (bool, error) {
+	return visitNames(element, strings.Split(fieldName, "."), visit)
+}
+
+func visitNames(element reflect.Value, names []string, visit func(field reflect.Value) error) (bool, error) {
+	for element.Kind() == reflect.Ptr || element.Kind() == reflect.Interface {
+		if element.IsNil() {
+			return false, nil
+		}
+		element = element.Elem()
+	}
+	if len(names) == 0 {
+		return true, visit(element)
+	}
+	switch element.Kind() {
+	case reflect.Slice, reflect.Array:
+		matched := false
+		for i := 0; i < element.Len(); i++ {
+			found, err := visitNames(element.Index(i), names, visit)
+			if err != nil {
+				return matched, err
+			}
+			matched = matched || found
+		}
+		return matched, nil
+	case reflect.Struct:
+		field := element.FieldByName(names[0])
+		if !field.IsValid() {
+			return false, nil
+		}
+		if len(names) == 1 {
+			return true, visit(field)
+		}
+		return visitNames(field, names[1:], visit)
+	}
+	return false, nil
+}
+
+// typeHasPath reports whether the dot-separated path names a field of the type,
+// following pointers and slice or array elements like visitPath does.
+func typeHasPath(typ reflect.Type, fieldName string) bool {
+	for _, name := range strings.Split(fieldName, ".") {
+		for typ.Kind() == reflect.Ptr || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return false
+		}
+		field, ok := typ.FieldByName(name)
+		if !ok {
+			return false
+		}
+		typ = field.Type
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+package reflection
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FieldChange is a field whose value differs between two versions of a struct.
+type FieldChange struct {
+	Path string `json:"path"`
+	Old  any    `json:"old"`
+	New  any    `json:"new"`
+}
+
+// StructDiff lists the exported fields that differ between two versions of a struct, by dot-separated
+// path in field order. Nested structs and pointers to structs are compared field by field; values with
+// an Equal method (such as time.Time) are compared with it; anything else is compared deeply as a whole.
+// It is built on Walk, so a struct reached again through an aliased or cyclic pointer is compared as a
+// whole at the second path rather than field by field.
+func StructDiff[T any](old, new T) ([]FieldChange, error) {
+	oldValue, newValue := reflect.ValueOf(old), reflect.ValueOf(new)
+	for oldValue.Kind() == reflect.Ptr && !oldValue.IsNil() && !newValue.IsNil() {
+		oldValue, newValue = oldValue.Elem(), newValue.Elem()
+	}
+	if oldValue.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("structDiff: expected a struct, got %s", oldValue.Kind())
+	}
+
+	// Both versions share a type, so every path visited in the old version was visited in the new one
+	// as long as StructDiff only descends where the new version descended too.
+	newFields := make(map[string]reflect.Value)
+	newDescended := make(map[string]bool)
+	newSeen := rootPointers(reflect.ValueOf(new))
+	err := Walk(new, func(path string, field reflect.Value) error {
+		newFields[path] = field
+		if descendDiff(field, newSeen) {
+			newDescended[path] = true
+			return nil
+		}
+		return SkipField
+	})
+	if err != nil {
+		return nil, fmt.Errorf("structDiff: %w", err)
+	}
+
+	changes := []FieldChange{}
+	oldSeen := rootPointers(reflect.ValueOf(old))
+	err = Walk(old, func(path string, oldField reflect.Value) error {
+		newField := newFields[path]
+		if equal, ok := equalByMethod(oldField, newField); ok {
+			if !equal {
+				changes = append(changes, FieldChange{Path: path, Old: oldField.Interface(), New: newField.Interface()})
+			}
+			return SkipField
+		}
+		if newDescended[path] && descendDiff(oldField, oldSeen) {
+			return nil
+		}
+		if !reflect.DeepEqual(oldField.Interface(), newField.Interface()) {
+			changes = append(changes, FieldChange{Path: path, Old: oldField.Interface(), New: newField.Interface()})
+		}
+		return SkipField
+	})
+	if err != nil {
+		return nil, fmt.Errorf("structDiff: %w", err)
+	}
+	return changes, nil
+}
+
+// descendDiff reports whether StructDiff compares the value field by field, marking a pointer as seen
+// when Walk is about to follow it.
+func descendDiff(value reflect.Value, seen map[walkKey]bool) bool {
+	if !isDiffable(value, seen) {
+		return false
+	}
+	if value.Kind() == reflect.Ptr {
+		seen[keyOf(value)] = true
+	}
+	return true
+}
+
+// isDiffable reports whether the value is a struct, or a pointer to a struct Walk has not followed yet.
+func isDiffable(value reflect.Value, seen map[walkKey]bool) bool {
+	switch value.Kind() {
+	case reflect.Struct:
+		return true
+	case reflect.Ptr:
+		return !value.IsNil() && value.Elem().Kind() == reflect.Struct && !seen[keyOf(value)]
+	}
+	return false
+}
+
+// rootPointers returns the pointers Walk follows before visiting the fields of the value.
+func rootPointers(value reflect.Value) map[walkKey]bool {
+	seen := make(map[walkKey]bool)
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		seen[keyOf(value)] = true
+		value = value.Elem()
+	}
+	return seen
+}
+
+// equalByMethod compares two values with their Equal(T) bool method, reporting false when there is none.
+func equalByMethod(old, new reflect.Value) (equal bool, ok bool) {
+	method := old.MethodByName("Equal")
+	if !method.IsValid() {
+		return false, false
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 1 || methodType.In(0) != old.Type() || methodType.NumOut() != 1 || methodType.Out(0).Kind() != reflect.Bool {
+		return false, false
+	}
+	if old.Kind() == reflect.Ptr && (old.IsNil() || new.IsNil()) {
+		return old.IsNil() == new.IsNil(), true
+	}
+	return method.Call([]reflect.Value{new})[0].Bool(), true
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
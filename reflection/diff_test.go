@@ -0,0 +1,105 @@
+package reflection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type diffAddress struct {
+	City string
+	Zip  string
+}
+
+type diffProfile struct {
+	Name      string
+	Age       int
+	Tags      []string
+	Address   diffAddress
+	Billing   *diffAddress
+	UpdatedAt time.Time
+	note      string
+}
+
+func TestStructDiff(t *testing.T) {
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("Success_field_level_changes", func(t *testing.T) {
+		old := diffProfile{Name: "Alice", Age: 30, Tags: []string{"a"}, Address: diffAddress{City: "Bangkok", Zip: "10110"}, Billing: &diffAddress{City: "Bangkok"}, UpdatedAt: now}
+		new := diffProfile{Name: "Alice", Age: 31, Tags: []string{"a", "b"}, Address: diffAddress{City: "Tokyo", Zip: "10110"}, Billing: &diffAddress{City: "Osaka"}, UpdatedAt: now.In(time.FixedZone("ICT", 7*3600)), note: "ignored"}
+
+		changes, err := StructDiff(old, new)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []FieldChange{
+			{Path: "Age", Old: 30, New: 31},
+			{Path: "Tags", Old: []string{"a"}, New: []string{"a", "b"}},
+			{Path: "Address.City", Old: "Bangkok", New: "Tokyo"},
+			{Path: "Billing.City", Old: "Bangkok", New: "Osaka"},
+		}, changes)
+	})
+
+	t.Run("Success_nil_pointer_change", func(t *testing.T) {
+		billing := &diffAddress{City: "Bangkok"}
+
+		changes, err := StructDiff(diffProfile{}, diffProfile{Billing: billing})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []FieldChange{{Path: "Billing", Old: (*diffAddress)(nil), New: billing}}, changes)
+	})
+
+	t.Run("Success_time_change", func(t *testing.T) {
+		changes, err := StructDiff(diffProfile{UpdatedAt: now}, diffProfile{UpdatedAt: now.Add(time.Second)})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []FieldChange{{Path: "UpdatedAt", Old: now, New: now.Add(time.Second)}}, changes)
+	})
+
+	t.Run("Success_pointers_and_no_changes", func(t *testing.T) {
+		profile := diffProfile{Name: "Alice"}
+
+		changes, err := StructDiff(&profile, &diffProfile{Name: "Alice"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []FieldChange{}, changes)
+	})
+
+	t.Run("Success_aliased_pointers", func(t *testing.T) {
+		type Order struct {
+			Billing  *diffAddress
+			Shipping *diffAddress
+		}
+		shared := &diffAddress{City: "Bangkok"}
+
+		changes, err := StructDiff(Order{Billing: shared, Shipping: shared}, Order{Billing: &diffAddress{City: "Bangkok"}, Shipping: &diffAddress{City: "Osaka"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []FieldChange{{Path: "Shipping", Old: shared, New: &diffAddress{City: "Osaka"}}}, changes)
+	})
+
+	t.Run("Success_cyclic_pointer", func(t *testing.T) {
+		type Node struct {
+			Name string
+			Next *Node
+		}
+		old := &Node{Name: "a"}
+		old.Next = old
+		new := &Node{Name: "b"}
+		new.Next = new
+
+		changes, err := StructDiff(old, new)
+
+		assert.NoError(t, err)
+		assert.Len(t, changes, 2)
+		assert.Equal(t, "Name", changes[0].Path)
+		assert.Equal(t, "Next", changes[1].Path)
+	})
+
+	t.Run("Error_not_struct", func(t *testing.T) {
+		changes, err := StructDiff(1, 2)
+
+		assert.Nil(t, changes)
+		assert.EqualError(t, err, "structDiff: expected a struct, got int")
+	})
+}
@@ -0,0 +1,191 @@
+package reflection
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Dump renders a value as stable, indented text: one struct field, element or map entry per line,
+// map keys sorted, strings quoted and pointers followed. Values implementing fmt.Stringer, such as
+// time.Time, are rendered with their String method. The output is meant for error messages and
+// line-based diffs, see DiffString.
+func Dump(value any) string {
+	var builder strings.Builder
+	dumpValue(&builder, reflect.ValueOf(value), 0, map[walkKey]bool{})
+	return builder.String()
+}
+
+// enterDump marks a pointer, map or slice as being dumped, writing <cycle> instead when it already is.
+func enterDump(builder *strings.Builder, value reflect.Value, visiting map[walkKey]bool) bool {
+	if visiting[keyOf(value)] {
+		builder.WriteString("<cycle>")
+		return false
+	}
+	visiting[keyOf(value)] = true
+	return true
+}
+
+var stringerType = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+
+func dumpValue(builder *strings.Builder, value reflect.Value, depth int, visiting map[walkKey]bool) {
+	if !value.IsValid() {
+		builder.WriteString("nil")
+		return
+	}
+	if value.Kind() != reflect.Ptr && value.Kind() != reflect.Interface && value.Type().Implements(stringerType) && value.CanInterface() {
+		builder.WriteString(value.Interface().(fmt.Stringer).String())
+		return
+	}
+	indent := strings.Repeat("  ", depth+1)
+	closing := strings.Repeat("  ", depth)
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+		if !enterDump(builder, value, visiting) {
+			return
+		}
+		defer delete(visiting, keyOf(value))
+		builder.WriteString("&")
+		dumpValue(builder, value.Elem(), depth, visiting)
+	case reflect.Interface:
+		if value.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+		dumpValue(builder, value.Elem(), depth, visiting)
+	case reflect.Struct:
+		builder.WriteString(value.Type().String())
+		if value.NumField() == 0 {
+			builder.WriteString("{}")
+			return
+		}
+		builder.WriteString("{\n")
+		for i := 0; i < value.NumField(); i++ {
+			builder.WriteString(indent + value.Type().Field(i).Name + ": ")
+			dumpValue(builder, value.Field(i), depth+1, visiting)
+			builder.WriteString(",\n")
+		}
+		builder.WriteString(closing + "}")
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+		if value.Len() == 0 {
+			builder.WriteString("[]")
+			return
+		}
+		if value.Kind() == reflect.Slice {
+			if !enterDump(builder, value, visiting) {
+				return
+			}
+			defer delete(visiting, keyOf(value))
+		}
+		builder.WriteString("[\n")
+		for i := 0; i < value.Len(); i++ {
+			builder.WriteString(indent)
+			dumpValue(builder, value.Index(i), depth+1, visiting)
+			builder.WriteString(",\n")
+		}
+		builder.WriteString(closing + "]")
+	case reflect.Map:
+		if value.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+		if value.Len() == 0 {
+			builder.WriteString("{}")
+			return
+		}
+		if !enterDump(builder, value, visiting) {
+			return
+		}
+		defer delete(visiting, keyOf(value))
+		type entry struct {
+			key   string
+			value reflect.Value
+		}
+		entries := make([]entry, 0, value.Len())
+		for _, key := range value.MapKeys() {
+			var keyBuilder strings.Builder
+			dumpValue(&keyBuilder, key, depth+1, visiting)
+			entries = append(entries, entry{key: keyBuilder.String(), value: value.MapIndex(key)})
+		}
+		sort.Slice(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+		builder.WriteString("{\n")
+		for _, e := range entries {
+			builder.WriteString(indent + e.key + ": ")
+			dumpValue(builder, e.value, depth+1, visiting)
+			builder.WriteString(",\n")
+		}
+		builder.WriteString(closing + "}")
+	case reflect.String:
+		builder.WriteString(strconv.Quote(value.String()))
+	case reflect.Bool:
+		builder.WriteString(strconv.FormatBool(value.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		builder.WriteString(strconv.FormatInt(value.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		builder.WriteString(strconv.FormatUint(value.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		builder.WriteString(strconv.FormatFloat(value.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		builder.WriteString(strconv.FormatComplex(value.Complex(), 'g', -1, 128))
+	default:
+		if value.IsNil() {
+			builder.WriteString("nil")
+			return
+		}
+		fmt.Fprintf(builder, "<%s>", value.Type())
+	}
+}
+
+// DiffString returns a line diff of Dump(a) and Dump(b): lines only in a are prefixed with "- ",
+// lines only in b with "+ " and common lines with "  ". It returns an empty string when both dumps
+// are identical.
+func DiffString(a, b any) string {
+	left := strings.Split(Dump(a), "\n")
+	right := strings.Split(Dump(b), "\n")
+	if strings.Join(left, "\n") == strings.Join(right, "\n") {
+		return ""
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of left[i:] and right[j:].
+	lcs := make([][]int, len(left)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(right)+1)
+	}
+	for i := len(left) - 1; i >= 0; i-- {
+		for j := len(right) - 1; j >= 0; j-- {
+			if left[i] == right[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var builder strings.Builder
+	i, j := 0, 0
+	for i < len(left) || j < len(right) {
+		switch {
+		case i < len(left) && j < len(right) && left[i] == right[j]:
+			builder.WriteString("  " + left[i] + "\n")
+			i++
+			j++
+		case i < len(left) && (j == len(right) || lcs[i+1][j] >= lcs[i][j+1]):
+			builder.WriteString("- " + left[i] + "\n")
+			i++
+		default:
+			builder.WriteString("+ " + right[j] + "\n")
+			j++
+		}
+	}
+	return builder.String()
+}
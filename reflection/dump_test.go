@@ -0,0 +1,100 @@
+package reflection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type dumpItem struct {
+	SKU   string
+	Price float64
+}
+
+type dumpOrder struct {
+	ID      int
+	Items   []dumpItem
+	Tags    map[string]int
+	Placed  time.Time
+	Note    *string
+	Parent  *dumpOrder
+	private bool
+}
+
+func TestDump(t *testing.T) {
+	t.Run("Success_nested_sorted_and_stable", func(t *testing.T) {
+		order := dumpOrder{
+			ID:     7,
+			Items:  []dumpItem{{SKU: "A", Price: 1.5}},
+			Tags:   map[string]int{"zeta": 1, "alpha": 2},
+			Placed: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+
+		assert.Equal(t, `reflection.dumpOrder{
+  ID: 7,
+  Items: [
+    reflection.dumpItem{
+      SKU: "A",
+      Price: 1.5,
+    },
+  ],
+  Tags: {
+    "alpha": 2,
+    "zeta": 1,
+  },
+  Placed: 2024-01-02 03:04:05 +0000 UTC,
+  Note: nil,
+  Parent: nil,
+  private: false,
+}`, Dump(order))
+	})
+
+	t.Run("Success_scalars_and_cycles", func(t *testing.T) {
+		cyclic := &dumpOrder{ID: 1}
+		cyclic.Parent = cyclic
+
+		assert.Equal(t, "nil", Dump(nil))
+		assert.Equal(t, `"hi"`, Dump("hi"))
+		assert.Equal(t, "[]", Dump([]int{}))
+		assert.Equal(t, "nil", Dump([]int(nil)))
+		assert.Contains(t, Dump(cyclic), "Parent: <cycle>,")
+	})
+
+	t.Run("Success_cyclic_map_and_slice", func(t *testing.T) {
+		cyclicMap := map[string]any{"name": "m"}
+		cyclicMap["self"] = cyclicMap
+		cyclicSlice := []any{"s", nil}
+		cyclicSlice[1] = cyclicSlice
+
+		assert.Equal(t, "{\n  \"name\": \"m\",\n  \"self\": <cycle>,\n}", Dump(cyclicMap))
+		assert.Equal(t, "[\n  \"s\",\n  <cycle>,\n]", Dump(cyclicSlice))
+		assert.NotEmpty(t, DiffString(cyclicMap, map[string]any{"name": "m"}))
+	})
+}
+
+func TestDiffString(t *testing.T) {
+	t.Run("Success_equal", func(t *testing.T) {
+		assert.Empty(t, DiffString(map[string]int{"a": 1}, map[string]int{"a": 1}))
+	})
+
+	t.Run("Success_marks_changed_lines", func(t *testing.T) {
+		diff := DiffString(
+			[]dumpItem{{SKU: "A", Price: 1}, {SKU: "B", Price: 2}},
+			[]dumpItem{{SKU: "A", Price: 1}, {SKU: "B", Price: 3}},
+		)
+
+		assert.Equal(t, `  [
+    reflection.dumpItem{
+      SKU: "A",
+      Price: 1,
+    },
+    reflection.dumpItem{
+      SKU: "B",
+-     Price: 2,
++     Price: 3,
+    },
+  ]
+`, diff)
+	})
+}
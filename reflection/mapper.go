@@ -0,0 +1,104 @@
+package reflection
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Converter converts values between two field types when copying between structs.
+type Converter struct {
+	From    reflect.Type
+	To      reflect.Type
+	Convert func(value any) (any, error)
+}
+
+// NewConverter creates a typed Converter from A to B.
+func NewConverter[A any, B any](convert func(value A) (B, error)) Converter {
+	return Converter{
+		From:    reflect.TypeOf((*A)(nil)).Elem(),
+		To:      reflect.TypeOf((*B)(nil)).Elem(),
+		Convert: func(value any) (any, error) { return convert(value.(A)) },
+	}
+}
+
+// CopyOptions configures CopyCommonFields.
+type CopyOptions struct {
+	// Tag matches fields by the name in this struct tag (e.g. "json") before falling back to the
+	// field name. Fields tagged "-" are skipped.
+	Tag string
+	// Converters handle fields whose types differ and are not numeric conversions.
+	Converters []Converter
+}
+
+// CopyCommonFields copies every exported field of src into the field of dst with the same name,
+// or the same tag name when options.Tag is set. Fields present in only one of the structs are left
+// alone. Differing types are handled by a matching converter or, for numbers, by conversion.
+func CopyCommonFields[S any, D any](src S, dst *D, options CopyOptions) error {
+	source := reflect.ValueOf(src)
+	for source.Kind() == reflect.Ptr {
+		if source.IsNil() {
+			return fmt.Errorf("copyCommonFields: source is nil")
+		}
+		source = source.Elem()
+	}
+	target := reflect.ValueOf(dst).Elem()
+	if source.Kind() != reflect.Struct || target.Kind() != reflect.Struct {
+		return fmt.Errorf("copyCommonFields: expected structs, got %s and %s", source.Kind(), target.Kind())
+	}
+
+	targetFields := make(map[string]reflect.Value)
+	for i := 0; i < target.NumField(); i++ {
+		if name, ok := copyFieldName(target.Type().Field(i), options.Tag); ok && target.Field(i).CanSet() {
+			targetFields[name] = target.Field(i)
+		}
+	}
+	for i := 0; i < source.NumField(); i++ {
+		field := source.Type().Field(i)
+		name, ok := copyFieldName(field, options.Tag)
+		if !ok || !field.IsExported() {
+			continue
+		}
+		targetField, exists := targetFields[name]
+		if !exists {
+			continue
+		}
+		value, err := convertField(source.Field(i), targetField.Type(), options.Converters)
+		if err != nil {
+			return fmt.Errorf("copyCommonFields: field %s: %w", name, err)
+		}
+		targetField.Set(value)
+	}
+	return nil
+}
+
+// copyFieldName returns the name used to match a field, and false when the field is excluded.
+func copyFieldName(field reflect.StructField, tag string) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	if tag == "" {
+		return field.Name, true
+	}
+	tagName, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+	switch tagName {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	}
+	return tagName, true
+}
+
+func convertField(value reflect.Value, target reflect.Type, converters []Converter) (reflect.Value, error) {
+	for _, converter := range converters {
+		if converter.From == value.Type() && converter.To == target {
+			converted, err := converter.Convert(value.Interface())
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			return convertValue(converted, target)
+		}
+	}
+	return convertValue(value.Interface(), target)
+}
@@ -0,0 +1,102 @@
+package reflection
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mapperUserDTO struct {
+	ID        string  `json:"id"`
+	FullName  string  `json:"name"`
+	Age       float64 `json:"age"`
+	CreatedAt string  `json:"created_at"`
+	Password  string  `json:"-"`
+}
+
+type mapperUser struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	Age       int       `json:"age"`
+	CreatedAt time.Time `json:"created_at"`
+	Password  string
+	internal  string
+}
+
+func TestCopyCommonFields(t *testing.T) {
+	parseID := NewConverter(strconv.Atoi)
+	parseTime := NewConverter(func(value string) (time.Time, error) { return time.Parse(time.DateOnly, value) })
+
+	t.Run("Success_by_tag_with_converters", func(t *testing.T) {
+		dto := mapperUserDTO{ID: "7", FullName: "Alice", Age: 30, CreatedAt: "2024-01-02", Password: "secret"}
+		user := mapperUser{internal: "kept"}
+
+		err := CopyCommonFields(dto, &user, CopyOptions{Tag: "json", Converters: []Converter{parseID, parseTime}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, mapperUser{
+			ID:        7,
+			Name:      "Alice",
+			Age:       30,
+			CreatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC),
+			internal:  "kept",
+		}, user)
+	})
+
+	t.Run("Success_by_name", func(t *testing.T) {
+		type source struct {
+			Name     string
+			Password string
+			Extra    bool
+		}
+		user := mapperUser{}
+
+		err := CopyCommonFields(&source{Name: "Bob", Password: "pw", Extra: true}, &user, CopyOptions{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Bob", user.Name)
+		assert.Equal(t, "pw", user.Password)
+	})
+
+	t.Run("Error_missing_converter", func(t *testing.T) {
+		user := mapperUser{}
+
+		err := CopyCommonFields(mapperUserDTO{ID: "7"}, &user, CopyOptions{Tag: "json"})
+
+		assert.EqualError(t, err, "copyCommonFields: field id: cannot assign string to int")
+	})
+
+	t.Run("Error_numeric_overflow", func(t *testing.T) {
+		type source struct {
+			Age int64
+		}
+		type target struct {
+			Age int8
+		}
+		copied := target{}
+
+		err := CopyCommonFields(source{Age: 300}, &copied, CopyOptions{})
+
+		assert.EqualError(t, err, "copyCommonFields: field Age: 300 overflows int8")
+	})
+
+	t.Run("Error_converter_failure", func(t *testing.T) {
+		user := mapperUser{}
+
+		err := CopyCommonFields(mapperUserDTO{ID: "x"}, &user, CopyOptions{Tag: "json", Converters: []Converter{parseID, parseTime}})
+
+		var numErr *strconv.NumError
+		assert.True(t, errors.As(err, &numErr))
+	})
+
+	t.Run("Error_not_structs", func(t *testing.T) {
+		value := 0
+
+		err := CopyCommonFields(mapperUser{}, &value, CopyOptions{})
+
+		assert.EqualError(t, err, "copyCommonFields: expected structs, got struct and int")
+	})
+}
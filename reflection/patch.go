@@ -0,0 +1,179 @@
+package reflection
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// SetField assigns a value to a nested field addressed by a dot-separated path.
+// The element must be addressable, e.g. reflect.ValueOf(&value). Nil pointers along the path are
+// allocated, and numeric values are converted to the field's numeric type (as decoded JSON requires).
+func SetField(element reflect.Value, fieldName string, value interface{}) error {
+	names := strings.Split(fieldName, ".")
+	for idx, name := range names {
+		for element.Kind() == reflect.Ptr {
+			if element.IsNil() {
+				if !element.CanSet() {
+					return fmt.Errorf("setField: nil pointer before field %s of %s", name, fieldName)
+				}
+				element.Set(reflect.New(element.Type().Elem()))
+			}
+			element = element.Elem()
+		}
+		if element.Kind() != reflect.Struct {
+			return fmt.Errorf("setField: cannot set field %s of %s on kind %s", name, fieldName, element.Kind())
+		}
+		element = element.FieldByName(name)
+		if !element.IsValid() {
+			return fmt.Errorf("setField: field %s does not exist", fieldName)
+		}
+		if idx == len(names)-1 && !element.CanSet() {
+			return fmt.Errorf("setField: field %s cannot be set", fieldName)
+		}
+	}
+	converted, err := convertValue(value, element.Type())
+	if err != nil {
+		return fmt.Errorf("setField: field %s: %w", fieldName, err)
+	}
+	element.Set(converted)
+	return nil
+}
+
+// convertValue adapts a value to the target type, allowing nil for the zero value
+// and conversions between numeric kinds that preserve the value (see convertNumber).
+func convertValue(value interface{}, target reflect.Type) (reflect.Value, error) {
+	if value == nil {
+		return reflect.Zero(target), nil
+	}
+	source := reflect.ValueOf(value)
+	if source.Type().AssignableTo(target) {
+		return source, nil
+	}
+	if isNumeric(source.Kind()) && isNumeric(target.Kind()) {
+		return convertNumber(source, target)
+	}
+	if source.Kind() == target.Kind() && source.Type().ConvertibleTo(target) {
+		return source.Convert(target), nil
+	}
+	return reflect.Value{}, fmt.Errorf("cannot assign %s to %s", source.Type(), target)
+}
+
+// convertNumber converts between numeric kinds, failing instead of wrapping on overflow, dropping a
+// fractional part or losing integer precision in a float. Floats converted to a smaller float are rounded.
+func convertNumber(source reflect.Value, target reflect.Type) (reflect.Value, error) {
+	converted := reflect.New(target).Elem()
+	switch {
+	case isInteger(target.Kind()):
+		exact, ok := integerOf(source)
+		if !ok {
+			return reflect.Value{}, fmt.Errorf("%v is not a whole number for %s", source, target)
+		}
+		if isSigned(target.Kind()) {
+			if !exact.IsInt64() || converted.OverflowInt(exact.Int64()) {
+				return reflect.Value{}, fmt.Errorf("%v overflows %s", source, target)
+			}
+			converted.SetInt(exact.Int64())
+		} else {
+			if !exact.IsUint64() || converted.OverflowUint(exact.Uint64()) {
+				return reflect.Value{}, fmt.Errorf("%v overflows %s", source, target)
+			}
+			converted.SetUint(exact.Uint64())
+		}
+	case isInteger(source.Kind()):
+		exact, _ := integerOf(source)
+		converted.Set(source.Convert(target))
+		if value, _ := new(big.Float).SetFloat64(converted.Float()).Int(nil); value.Cmp(exact) != 0 {
+			return reflect.Value{}, fmt.Errorf("%v loses precision as %s", source, target)
+		}
+	default:
+		if value := source.Float(); !math.IsInf(value, 0) && converted.OverflowFloat(value) {
+			return reflect.Value{}, fmt.Errorf("%v overflows %s", source, target)
+		}
+		converted.Set(source.Convert(target))
+	}
+	return converted, nil
+}
+
+// integerOf returns the exact integer held by a numeric value, reporting false for floats with a
+// fractional part, NaN or infinities.
+func integerOf(value reflect.Value) (*big.Int, bool) {
+	switch {
+	case isSigned(value.Kind()):
+		return big.NewInt(value.Int()), true
+	case isInteger(value.Kind()):
+		return new(big.Int).SetUint64(value.Uint()), true
+	}
+	float := value.Float()
+	if math.IsNaN(float) || math.IsInf(float, 0) || float != math.Trunc(float) {
+		return nil, false
+	}
+	exact, _ := big.NewFloat(float).Int(nil)
+	return exact, true
+}
+
+func isSigned(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
+
+func isInteger(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Uint64
+}
+
+func isNumeric(kind reflect.Kind) bool {
+	return (kind >= reflect.Int && kind <= reflect.Uint64) || kind == reflect.Float32 || kind == reflect.Float64
+}
+
+// MergeNonZero copies every non-zero exported field of src into dst, leaving the other fields of dst
+// untouched. Nested structs are merged field by field; other values, including pointers, slices and
+// maps, and structs without exported fields such as time.Time, are replaced as a whole when non-zero.
+func MergeNonZero[T any](dst *T, src T) {
+	mergeNonZero(reflect.ValueOf(dst).Elem(), reflect.ValueOf(src))
+}
+
+func mergeNonZero(dst reflect.Value, src reflect.Value) {
+	if src.Kind() != reflect.Struct || !hasSettableField(dst) {
+		if dst.CanSet() && !src.IsZero() {
+			dst.Set(src)
+		}
+		return
+	}
+	for i := 0; i < src.NumField(); i++ {
+		if dst.Field(i).CanSet() {
+			mergeNonZero(dst.Field(i), src.Field(i))
+		}
+	}
+}
+
+func hasSettableField(value reflect.Value) bool {
+	for i := 0; i < value.NumField(); i++ {
+		if value.Field(i).CanSet() {
+			return true
+		}
+	}
+	return false
+}
+
+// Patch assigns each value of the patch to the field at its dot-separated path in dst.
+// Paths are applied in sorted order, so a parent path is applied before its children.
+// The patch is applied to a deep copy of dst, which replaces dst only when every path succeeds, so a
+// failed patch leaves dst untouched; on success the pointers, slices and maps of dst are fresh copies.
+func Patch[T any](dst *T, patch map[string]any) error {
+	paths := make([]string, 0, len(patch))
+	for path := range patch {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	patched := DeepCopy(*dst)
+	target := reflect.ValueOf(&patched)
+	for _, path := range paths {
+		if err := SetField(target, path, patch[path]); err != nil {
+			return fmt.Errorf("patch: %w", err)
+		}
+	}
+	*dst = patched
+	return nil
+}
@@ -0,0 +1,157 @@
+package reflection
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type patchAddress struct {
+	City string
+	Zip  string
+}
+
+type patchUser struct {
+	Name    string
+	Age     int
+	Active  bool
+	Tags    []string
+	Address patchAddress
+	Manager *patchUser
+	secret  string
+}
+
+func TestSetField(t *testing.T) {
+	t.Run("Success_nested_and_allocates_pointers", func(t *testing.T) {
+		user := patchUser{}
+
+		assert.NoError(t, SetField(reflect.ValueOf(&user), "Address.City", "Bangkok"))
+		assert.NoError(t, SetField(reflect.ValueOf(&user), "Manager.Name", "Alice"))
+
+		assert.Equal(t, "Bangkok", user.Address.City)
+		assert.Equal(t, "Alice", user.Manager.Name)
+	})
+
+	t.Run("Success_numeric_conversion_and_nil", func(t *testing.T) {
+		user := patchUser{Tags: []string{"a"}}
+
+		assert.NoError(t, SetField(reflect.ValueOf(&user), "Age", 42.0))
+		assert.NoError(t, SetField(reflect.ValueOf(&user), "Tags", nil))
+
+		assert.Equal(t, 42, user.Age)
+		assert.Nil(t, user.Tags)
+	})
+
+	t.Run("Error_cases", func(t *testing.T) {
+		user := patchUser{}
+
+		assert.EqualError(t, SetField(reflect.ValueOf(&user), "Missing", 1), "setField: field Missing does not exist")
+		assert.EqualError(t, SetField(reflect.ValueOf(&user), "Name", 1), "setField: field Name: cannot assign int to string")
+		assert.EqualError(t, SetField(reflect.ValueOf(&user), "secret", "x"), "setField: field secret cannot be set")
+		assert.EqualError(t, SetField(reflect.ValueOf(&user), "Name.First", "x"), "setField: cannot set field First of Name.First on kind string")
+	})
+
+	t.Run("Error_lossy_numeric_conversion", func(t *testing.T) {
+		type Limits struct {
+			Small int8
+			Count uint
+			Age   int
+			Ratio float32
+			Exact float64
+		}
+		limits := Limits{}
+		target := reflect.ValueOf(&limits)
+
+		assert.EqualError(t, SetField(target, "Small", int64(300)), "setField: field Small: 300 overflows int8")
+		assert.EqualError(t, SetField(target, "Count", -1), "setField: field Count: -1 overflows uint")
+		assert.EqualError(t, SetField(target, "Age", 3.9), "setField: field Age: 3.9 is not a whole number for int")
+		assert.EqualError(t, SetField(target, "Age", 1e30), "setField: field Age: 1e+30 overflows int")
+		assert.EqualError(t, SetField(target, "Ratio", 1e300), "setField: field Ratio: 1e+300 overflows float32")
+		assert.EqualError(t, SetField(target, "Exact", int64(1<<53+1)), "setField: field Exact: 9007199254740993 loses precision as float64")
+		assert.Equal(t, Limits{}, limits)
+
+		assert.NoError(t, SetField(target, "Small", int64(-128)))
+		assert.NoError(t, SetField(target, "Count", 7.0))
+		assert.NoError(t, SetField(target, "Ratio", 0.1))
+		assert.Equal(t, Limits{Small: -128, Count: 7, Ratio: 0.1}, limits)
+	})
+}
+
+func TestMergeNonZero(t *testing.T) {
+	t.Run("Success_only_non_zero_fields", func(t *testing.T) {
+		dst := patchUser{Name: "Alice", Age: 30, Active: true, Address: patchAddress{City: "Bangkok", Zip: "10110"}}
+		src := patchUser{Age: 31, Tags: []string{"vip"}, Address: patchAddress{Zip: "10120"}}
+
+		MergeNonZero(&dst, src)
+
+		expected := patchUser{Name: "Alice", Age: 31, Active: true, Tags: []string{"vip"}, Address: patchAddress{City: "Bangkok", Zip: "10120"}}
+		assert.Equal(t, expected, dst)
+	})
+
+	t.Run("Success_time_replaced_whole", func(t *testing.T) {
+		type Event struct {
+			Name string
+			At   time.Time
+		}
+		at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		dst := Event{Name: "a"}
+
+		MergeNonZero(&dst, Event{At: at})
+		assert.Equal(t, Event{Name: "a", At: at}, dst)
+
+		MergeNonZero(&dst, Event{Name: "b"})
+		assert.Equal(t, Event{Name: "b", At: at}, dst)
+	})
+
+	t.Run("Success_non_struct", func(t *testing.T) {
+		value := 5
+
+		MergeNonZero(&value, 0)
+		assert.Equal(t, 5, value)
+
+		MergeNonZero(&value, 7)
+		assert.Equal(t, 7, value)
+	})
+}
+
+func TestPatch(t *testing.T) {
+	t.Run("Success_from_json_payload", func(t *testing.T) {
+		user := patchUser{Name: "Alice", Age: 30, Address: patchAddress{City: "Bangkok"}}
+		patch := map[string]any{}
+		assert.NoError(t, json.Unmarshal([]byte(`{"Age": 31, "Address.Zip": "10110", "Active": true}`), &patch))
+
+		err := Patch(&user, patch)
+
+		assert.NoError(t, err)
+		assert.Equal(t, patchUser{Name: "Alice", Age: 31, Active: true, Address: patchAddress{City: "Bangkok", Zip: "10110"}}, user)
+	})
+
+	t.Run("Success_parent_before_child", func(t *testing.T) {
+		user := patchUser{Address: patchAddress{City: "Bangkok", Zip: "10110"}}
+
+		err := Patch(&user, map[string]any{"Address.City": "Tokyo", "Address": patchAddress{Zip: "100"}})
+
+		assert.NoError(t, err)
+		assert.Equal(t, patchAddress{City: "Tokyo", Zip: "100"}, user.Address)
+	})
+
+	t.Run("Error_invalid_path", func(t *testing.T) {
+		user := patchUser{}
+
+		err := Patch(&user, map[string]any{"Address.Country": "TH"})
+
+		assert.EqualError(t, err, "patch: setField: field Address.Country does not exist")
+	})
+
+	t.Run("Error_leaves_dst_untouched", func(t *testing.T) {
+		user := patchUser{Name: "Alice", Age: 30, Manager: &patchUser{Name: "Bob"}}
+
+		err := Patch(&user, map[string]any{"Name": "Carol", "Manager.Name": "Dave", "Age": "oops"})
+
+		assert.EqualError(t, err, "patch: setField: field Age: cannot assign string to int")
+		assert.Equal(t, patchUser{Name: "Alice", Age: 30, Manager: &patchUser{Name: "Bob"}}, user)
+	})
+}
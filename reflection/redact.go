@@ -0,0 +1,42 @@
+package reflection
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Redact returns a deep copy of the value with the fields at the given dot-separated paths replaced,
+// e.g. blanking passwords and tokens before logging. Paths may traverse pointers and fan out over
+// slices. A nil replacement sets the zero value. The original value is never modified.
+// A path matching no field is reported as an error so that typos do not leak sensitive data; on any
+// error the zero value is returned rather than the original.
+func Redact[T any](value T, paths []string, replacement any) (T, error) {
+	var zero T
+	redacted := DeepCopy(value)
+	targets := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		if !typeHasPath(reflect.TypeOf(&redacted).Elem(), path) {
+			return zero, fmt.Errorf("redact: field %s does not exist", path)
+		}
+		targets[path] = true
+	}
+	err := Walk(&redacted, func(path string, field reflect.Value) error {
+		fieldPath := fieldPathOf(path)
+		if !targets[fieldPath] {
+			return nil
+		}
+		if !field.CanSet() {
+			return fmt.Errorf("field %s cannot be set", fieldPath)
+		}
+		converted, err := convertValue(replacement, field.Type())
+		if err != nil {
+			return fmt.Errorf("field %s: %w", fieldPath, err)
+		}
+		field.Set(converted)
+		return SkipField
+	})
+	if err != nil {
+		return zero, fmt.Errorf("redact: %w", err)
+	}
+	return redacted, nil
+}
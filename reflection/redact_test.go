@@ -0,0 +1,101 @@
+package reflection
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type redactCredential struct {
+	Provider string
+	Token    string
+}
+
+type redactAccount struct {
+	User        string
+	Password    string
+	PIN         int
+	Credentials []redactCredential
+	Primary     *redactCredential
+}
+
+func TestRedact(t *testing.T) {
+	account := redactAccount{
+		User:        "alice",
+		Password:    "hunter2",
+		PIN:         1234,
+		Credentials: []redactCredential{{Provider: "github", Token: "gh-123"}, {Provider: "gitlab", Token: "gl-456"}},
+		Primary:     &redactCredential{Provider: "github", Token: "gh-123"},
+	}
+
+	t.Run("Success_replaces_nested_and_slice_fields", func(t *testing.T) {
+		redacted, err := Redact(account, []string{"Password", "Credentials.Token", "Primary.Token"}, "***")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", redacted.User)
+		assert.Equal(t, "***", redacted.Password)
+		assert.Equal(t, []redactCredential{{Provider: "github", Token: "***"}, {Provider: "gitlab", Token: "***"}}, redacted.Credentials)
+		assert.Equal(t, "***", redacted.Primary.Token)
+	})
+
+	t.Run("Success_original_untouched", func(t *testing.T) {
+		_, err := Redact(account, []string{"Password", "Credentials.Token", "Primary.Token"}, "***")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "hunter2", account.Password)
+		assert.Equal(t, "gh-123", account.Credentials[0].Token)
+		assert.Equal(t, "gh-123", account.Primary.Token)
+	})
+
+	t.Run("Success_nil_replacement_zeroes", func(t *testing.T) {
+		redacted, err := Redact(account, []string{"PIN", "Password"}, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 0, redacted.PIN)
+		assert.Equal(t, "", redacted.Password)
+	})
+
+	t.Run("Success_empty_slice_and_nil_pointer", func(t *testing.T) {
+		redacted, err := Redact(redactAccount{User: "bob"}, []string{"Credentials.Token", "Primary.Token"}, "***")
+
+		assert.NoError(t, err)
+		assert.Equal(t, redactAccount{User: "bob"}, redacted)
+	})
+
+	t.Run("Success_cyclic_map", func(t *testing.T) {
+		type Event struct {
+			Password string
+			Payload  map[string]any
+		}
+		payload := map[string]any{"kind": "login"}
+		payload["self"] = payload
+
+		redacted, err := Redact(Event{Password: "hunter2", Payload: payload}, []string{"Password"}, "***")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "***", redacted.Password)
+		assert.Equal(t, "login", redacted.Payload["kind"])
+	})
+
+	t.Run("Error_unknown_path", func(t *testing.T) {
+		_, err := Redact(account, []string{"Passwd"}, "***")
+
+		assert.EqualError(t, err, "redact: field Passwd does not exist")
+	})
+
+	t.Run("Error_replacement_type", func(t *testing.T) {
+		_, err := Redact(account, []string{"PIN"}, "***")
+
+		assert.EqualError(t, err, "redact: field PIN: cannot assign string to int")
+	})
+
+	t.Run("Error_returns_no_secret", func(t *testing.T) {
+		unknown, err := Redact(account, []string{"Password", "Passwd"}, "***")
+		assert.Error(t, err)
+		assert.Equal(t, redactAccount{}, unknown)
+
+		mistyped, err := Redact(account, []string{"Password", "PIN"}, "***")
+		assert.Error(t, err)
+		assert.Equal(t, redactAccount{}, mistyped)
+	})
+}
@@ -2,15 +2,33 @@ package reflection
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 )
 
 // GetField retrieves the value of a nested field by name.
+// It never panics: if the path cannot be followed, the returned value is invalid.
 func GetField(element reflect.Value, fieldName string) reflect.Value {
+	value, _ := GetFieldReturnWithError(element, fieldName)
+	return value
+}
+
+// GetFieldReturnWithError retrieves the value of a nested field by name, describing why the path
+// could not be followed instead of panicking (missing field, nil pointer, non-struct value).
+func GetFieldReturnWithError(element reflect.Value, fieldName string) (result reflect.Value, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = reflect.Value{}
+			err = fmt.Errorf("getField: field %s could not be read: %v", fieldName, r)
+		}
+	}()
 	names := strings.Split(fieldName, ".")
 	for _, name := range names {
 		if element.Kind() == reflect.Ptr {
+			if element.IsNil() {
+				return reflect.Value{}, fmt.Errorf("getField: nil pointer before field %s of %s", name, fieldName)
+			}
 			element = element.Elem()
 		}
 		if element.Kind() == reflect.Slice {
@@ -26,11 +44,17 @@ func GetField(element reflect.Value, fieldName string) reflect.Value {
 			for i, v := range subElements {
 				result[i] = v.Interface()
 			}
-			return reflect.ValueOf(result)
+			return reflect.ValueOf(result), nil
+		}
+		if element.Kind() != reflect.Struct {
+			return reflect.Value{}, fmt.Errorf("getField: cannot read field %s of %s from kind %s", name, fieldName, element.Kind())
 		}
 		element = element.FieldByName(name)
+		if !element.IsValid() {
+			return reflect.Value{}, fmt.Errorf("getField: field %s does not exist", fieldName)
+		}
 	}
-	return element
+	return element, nil
 }
 
 // Case attempts to convert an interface{} to a specific type and returns a pointer to the result.
@@ -200,3 +200,51 @@ func Test_CaseObject(t *testing.T) {
 	})
 
 }
+
+func TestGetFieldReturnWithError(t *testing.T) {
+	type Layer2 struct {
+		Field1 string
+	}
+	type MyStruct struct {
+		Name   string
+		Layer2 *Layer2
+	}
+
+	t.Run("Success_nested_pointer", func(t *testing.T) {
+		data := MyStruct{Name: "John", Layer2: &Layer2{Field1: "Value1"}}
+
+		value, err := GetFieldReturnWithError(reflect.ValueOf(data), "Layer2.Field1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "Value1", value.Interface())
+	})
+
+	t.Run("Error_field_does_not_exist", func(t *testing.T) {
+		value, err := GetFieldReturnWithError(reflect.ValueOf(MyStruct{}), "Nonexistent")
+
+		assert.False(t, value.IsValid())
+		assert.EqualError(t, err, "getField: field Nonexistent does not exist")
+	})
+
+	t.Run("Error_nil_pointer", func(t *testing.T) {
+		value, err := GetFieldReturnWithError(reflect.ValueOf(MyStruct{}), "Layer2.Field1")
+
+		assert.False(t, value.IsValid())
+		assert.EqualError(t, err, "getField: nil pointer before field Field1 of Layer2.Field1")
+	})
+
+	t.Run("Error_non_struct_value", func(t *testing.T) {
+		value, err := GetFieldReturnWithError(reflect.ValueOf(MyStruct{Name: "John"}), "Name.Length")
+
+		assert.False(t, value.IsValid())
+		assert.EqualError(t, err, "getField: cannot read field Length of Name.Length from kind string")
+	})
+
+	t.Run("GetField_does_not_panic", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			assert.False(t, GetField(reflect.ValueOf(MyStruct{}), "Layer2.Field1").IsValid())
+			assert.False(t, GetField(reflect.ValueOf(42), "Field").IsValid())
+			assert.False(t, GetField(reflect.Value{}, "Field").IsValid())
+		})
+	})
+}
@@ -0,0 +1,151 @@
+package reflection
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// TransformTag is the struct tag read by ApplyTransforms, e.g. `fp:"trim,lower"`.
+const TransformTag = "fp"
+
+// Transform rewrites a settable field value in place.
+type Transform func(value reflect.Value) error
+
+var transforms = struct {
+	sync.RWMutex
+	byTag  map[string]Transform
+	byType map[reflect.Type]Transform
+}{
+	byTag: map[string]Transform{
+		"trim":  StringTransform(strings.TrimSpace),
+		"lower": StringTransform(strings.ToLower),
+		"upper": StringTransform(strings.ToUpper),
+	},
+	byType: map[reflect.Type]Transform{},
+}
+
+// RegisterTagTransform registers a transform applied to fields tagged with its name.
+// The built-in "trim", "lower" and "upper" transforms can be replaced.
+func RegisterTagTransform(name string, transform Transform) {
+	transforms.Lock()
+	defer transforms.Unlock()
+	transforms.byTag[name] = transform
+}
+
+// RegisterTypeTransform registers a transform applied to every settable value of type T,
+// regardless of tags.
+func RegisterTypeTransform[T any](transform func(value T) T) {
+	transforms.Lock()
+	defer transforms.Unlock()
+	transforms.byType[reflect.TypeOf((*T)(nil)).Elem()] = func(value reflect.Value) error {
+		value.Set(reflect.ValueOf(transform(value.Interface().(T))))
+		return nil
+	}
+}
+
+// StringTransform adapts a string function to a Transform for string and []string fields.
+func StringTransform(transform func(string) string) Transform {
+	return func(value reflect.Value) error {
+		switch {
+		case value.Kind() == reflect.String:
+			value.SetString(transform(value.String()))
+		case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.String:
+			for i := 0; i < value.Len(); i++ {
+				value.Index(i).SetString(transform(value.Index(i).String()))
+			}
+		default:
+			return fmt.Errorf("is of type %s", value.Type())
+		}
+		return nil
+	}
+}
+
+// ApplyTransforms walks the value, applying registered type transforms to every settable value and
+// the tag transforms named in `fp:"..."` tags, left to right, to the tagged fields.
+// Type transforms run before tag transforms. A struct reached through several pointers is transformed
+// once. The registry is copied up front, so transforms may themselves call Register*; registrations
+// made while ApplyTransforms runs take effect on the next call.
+func ApplyTransforms[T any](value *T) error {
+	applier := newTransformApplier()
+	root := reflect.ValueOf(value).Elem()
+	err := applier.applyAt("", root)
+	if err == nil {
+		err = Walk(value, func(path string, field reflect.Value) error {
+			return applier.applyAt(path, field)
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("applyTransforms: %w", err)
+	}
+	return nil
+}
+
+// transformTarget identifies a struct by address and type, since a struct and its first field
+// share an address.
+type transformTarget struct {
+	addr uintptr
+	typ  reflect.Type
+}
+
+// transformApplier applies a snapshot of the registry, remembering which structs it has transformed.
+type transformApplier struct {
+	byTag   map[string]Transform
+	byType  map[reflect.Type]Transform
+	applied map[transformTarget]bool
+}
+
+func newTransformApplier() *transformApplier {
+	transforms.RLock()
+	defer transforms.RUnlock()
+	return &transformApplier{
+		byTag:   maps.Clone(transforms.byTag),
+		byType:  maps.Clone(transforms.byType),
+		applied: make(map[transformTarget]bool),
+	}
+}
+
+func (a *transformApplier) applyAt(path string, value reflect.Value) error {
+	if !value.CanSet() {
+		return nil
+	}
+	if transform, ok := a.byType[value.Type()]; ok {
+		if err := transform(value); err != nil {
+			return fmt.Errorf("field %s: %w", path, err)
+		}
+	}
+	for value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	target := transformTarget{addr: value.UnsafeAddr(), typ: value.Type()}
+	if a.applied[target] {
+		return nil
+	}
+	a.applied[target] = true
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		tag, ok := field.Tag.Lookup(TransformTag)
+		if !ok || !field.IsExported() {
+			continue
+		}
+		for _, name := range strings.Split(tag, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" {
+				continue
+			}
+			transform, ok := a.byTag[name]
+			if !ok {
+				return fmt.Errorf("unknown transform %s on field %s", name, joinPath(path, field.Name))
+			}
+			if err := transform(value.Field(i)); err != nil {
+				return fmt.Errorf("transform %s on field %s: %w", name, joinPath(path, field.Name), err)
+			}
+		}
+	}
+	return nil
+}
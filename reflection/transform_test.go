@@ -0,0 +1,120 @@
+package reflection
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type transformAddress struct {
+	City    string `fp:"trim,upper"`
+	Country string `fp:"trim"`
+}
+
+type transformSignup struct {
+	Email     string   `fp:"trim,lower"`
+	Name      string   `fp:"trim"`
+	Tags      []string `fp:"lower"`
+	Addresses []transformAddress
+	Billing   *transformAddress
+	Note      string
+}
+
+type transformCents int64
+
+func TestApplyTransforms(t *testing.T) {
+	t.Run("Success_applies_tags_recursively", func(t *testing.T) {
+		signup := transformSignup{
+			Email:     "  Alice@Example.COM ",
+			Name:      " Alice ",
+			Tags:      []string{"VIP", "New"},
+			Addresses: []transformAddress{{City: " bangkok ", Country: " TH "}},
+			Billing:   &transformAddress{City: "paris", Country: "FR "},
+			Note:      "  untouched  ",
+		}
+
+		err := ApplyTransforms(&signup)
+
+		assert.NoError(t, err)
+		assert.Equal(t, transformSignup{
+			Email:     "alice@example.com",
+			Name:      "Alice",
+			Tags:      []string{"vip", "new"},
+			Addresses: []transformAddress{{City: "BANGKOK", Country: "TH"}},
+			Billing:   &transformAddress{City: "PARIS", Country: "FR"},
+			Note:      "  untouched  ",
+		}, signup)
+	})
+
+	t.Run("Success_custom_tag_and_type_transforms", func(t *testing.T) {
+		type Payment struct {
+			Reference string `fp:"squash"`
+			Amount    transformCents
+		}
+		RegisterTagTransform("squash", StringTransform(func(s string) string { return strings.ReplaceAll(s, " ", "") }))
+		RegisterTypeTransform(func(c transformCents) transformCents { return max(c, 0) })
+		payment := Payment{Reference: "AB 12 C", Amount: -50}
+
+		err := ApplyTransforms(&payment)
+
+		assert.NoError(t, err)
+		assert.Equal(t, Payment{Reference: "AB12C", Amount: 0}, payment)
+	})
+
+	t.Run("Error_unknown_transform", func(t *testing.T) {
+		type Input struct {
+			Name string `fp:"titlecase"`
+		}
+
+		err := ApplyTransforms(&Input{})
+
+		assert.EqualError(t, err, "applyTransforms: unknown transform titlecase on field Name")
+	})
+
+	t.Run("Error_wrong_field_type", func(t *testing.T) {
+		type Outer struct {
+			Inner struct {
+				Count int `fp:"trim"`
+			}
+		}
+
+		err := ApplyTransforms(&Outer{})
+
+		assert.EqualError(t, err, "applyTransforms: transform trim on field Inner.Count: is of type int")
+	})
+
+	t.Run("Success_aliased_struct_transformed_once", func(t *testing.T) {
+		type Addr struct {
+			Line string `fp:"bang"`
+		}
+		type Order struct {
+			Billing  *Addr
+			Shipping *Addr
+		}
+		RegisterTagTransform("bang", StringTransform(func(s string) string { return s + "!" }))
+		shared := &Addr{Line: "x"}
+		order := Order{Billing: shared, Shipping: shared}
+
+		err := ApplyTransforms(&order)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "x!", shared.Line)
+	})
+
+	t.Run("Success_transform_may_register", func(t *testing.T) {
+		type Input struct {
+			Name string `fp:"registering"`
+		}
+		RegisterTagTransform("registering", func(value reflect.Value) error {
+			RegisterTagTransform("registered", StringTransform(strings.TrimSpace))
+			return nil
+		})
+
+		err := ApplyTransforms(&Input{})
+
+		assert.NoError(t, err)
+	})
+
+}
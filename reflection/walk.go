@@ -0,0 +1,115 @@
+package reflection
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+)
+
+// SkipField can be returned by a Walk visit function to skip the children of the visited value.
+var SkipField = errors.New("skip this field")
+
+// Walk visits every exported field, slice or array element and map entry reachable from the value,
+// parent before children. Paths join field names with dots and add element indices and map keys in
+// brackets, e.g. "Lines[0].SKU" or "Attrs[color]"; map keys are visited in sorted order. Pointers and
+// interfaces are followed transparently and each pointer is followed only once; a map or slice already
+// being walked is not entered again, so cycles terminate.
+// Walking a pointer yields settable values. A visit error stops the walk and is returned, except SkipField.
+func Walk(value any, visit func(path string, v reflect.Value) error) error {
+	w := &walker{visit: visit, visited: make(map[walkKey]bool)}
+	return w.walkChildren("", reflect.ValueOf(value))
+}
+
+type walker struct {
+	visit   func(path string, v reflect.Value) error
+	visited map[walkKey]bool
+}
+
+// walkKey identifies a pointer, map or slice by address and type, since a struct and its first field
+// share an address, and by length, since a slice and its shorter reslices do.
+type walkKey struct {
+	addr uintptr
+	typ  reflect.Type
+	len  int
+}
+
+func keyOf(value reflect.Value) walkKey {
+	key := walkKey{addr: value.Pointer(), typ: value.Type()}
+	if value.Kind() == reflect.Slice {
+		key.len = value.Len()
+	}
+	return key
+}
+
+func (w *walker) walkValue(path string, value reflect.Value) error {
+	if err := w.visit(path, value); err != nil {
+		if errors.Is(err, SkipField) {
+			return nil
+		}
+		return err
+	}
+	return w.walkChildren(path, value)
+}
+
+func (w *walker) walkChildren(path string, value reflect.Value) error {
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() || w.visited[keyOf(value)] {
+			return nil
+		}
+		w.visited[keyOf(value)] = true
+		return w.walkChildren(path, value.Elem())
+	case reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return w.walkChildren(path, value.Elem())
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if err := w.walkValue(joinPath(path, field.Name), value.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.Len() > 0 {
+			if w.visited[keyOf(value)] {
+				return nil
+			}
+			w.visited[keyOf(value)] = true
+			defer delete(w.visited, keyOf(value))
+		}
+		for i := 0; i < value.Len(); i++ {
+			if err := w.walkValue(fmt.Sprintf("%s[%d]", path, i), value.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		if value.IsNil() || w.visited[keyOf(value)] {
+			return nil
+		}
+		w.visited[keyOf(value)] = true
+		defer delete(w.visited, keyOf(value))
+		keys := value.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, key := range keys {
+			if err := w.walkValue(fmt.Sprintf("%s[%v]", path, key), value.MapIndex(key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+var walkIndexPattern = regexp.MustCompile(`\[[^\]]*\]`)
+
+// fieldPathOf strips element indices and map keys from a Walk path, giving the dot-separated
+// field path used by GetField and friends.
+func fieldPathOf(walkPath string) string {
+	return walkIndexPattern.ReplaceAllString(walkPath, "")
+}
@@ -0,0 +1,150 @@
+package reflection
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type walkLine struct {
+	SKU string
+	Qty int
+}
+
+type walkOrder struct {
+	ID     string
+	Lines  []walkLine
+	Attrs  map[string]string
+	Parent *walkOrder
+	hidden string
+}
+
+func TestWalk(t *testing.T) {
+	order := walkOrder{
+		ID:     "O1",
+		Lines:  []walkLine{{SKU: "A", Qty: 1}, {SKU: "B", Qty: 2}},
+		Attrs:  map[string]string{"size": "L", "color": "red"},
+		hidden: "x",
+	}
+
+	t.Run("Success_visits_every_field_in_order", func(t *testing.T) {
+		paths := []string{}
+
+		err := Walk(order, func(path string, v reflect.Value) error {
+			paths = append(paths, path)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			"ID",
+			"Lines", "Lines[0]", "Lines[0].SKU", "Lines[0].Qty", "Lines[1]", "Lines[1].SKU", "Lines[1].Qty",
+			"Attrs", "Attrs[color]", "Attrs[size]",
+			"Parent",
+		}, paths)
+	})
+
+	t.Run("Success_skip_field", func(t *testing.T) {
+		paths := []string{}
+
+		err := Walk(order, func(path string, v reflect.Value) error {
+			paths = append(paths, path)
+			if path == "Lines" || path == "Attrs" {
+				return SkipField
+			}
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"ID", "Lines", "Attrs", "Parent"}, paths)
+	})
+
+	t.Run("Success_settable_through_pointer", func(t *testing.T) {
+		copied := DeepCopy(order)
+
+		err := Walk(&copied, func(path string, v reflect.Value) error {
+			if v.Kind() == reflect.Int && v.CanSet() {
+				v.SetInt(v.Int() * 10)
+			}
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []walkLine{{SKU: "A", Qty: 10}, {SKU: "B", Qty: 20}}, copied.Lines)
+	})
+
+	t.Run("Success_cycles_terminate", func(t *testing.T) {
+		cyclic := &walkOrder{ID: "self"}
+		cyclic.Parent = cyclic
+		count := 0
+
+		err := Walk(cyclic, func(path string, v reflect.Value) error {
+			count++
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 4, count)
+	})
+
+	t.Run("Success_cyclic_map_and_slice_terminate", func(t *testing.T) {
+		cyclicMap := map[string]any{"name": "m"}
+		cyclicMap["self"] = cyclicMap
+		cyclicSlice := []any{"s", nil}
+		cyclicSlice[1] = cyclicSlice
+		paths := []string{}
+
+		err := Walk(struct{ M map[string]any }{M: cyclicMap}, func(path string, v reflect.Value) error {
+			paths = append(paths, path)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"M", "M[name]", "M[self]"}, paths)
+
+		paths = []string{}
+		err = Walk(cyclicSlice, func(path string, v reflect.Value) error {
+			paths = append(paths, path)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"[0]", "[1]"}, paths)
+	})
+
+	t.Run("Success_pointer_to_first_field", func(t *testing.T) {
+		type Inner struct {
+			X int
+		}
+		type Outer struct {
+			A *Inner
+			B *int
+		}
+		in := &Inner{X: 1}
+		paths := []string{}
+
+		err := Walk(Outer{A: in, B: &in.X}, func(path string, v reflect.Value) error {
+			paths = append(paths, path)
+			return nil
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"A", "A.X", "B"}, paths)
+	})
+
+	t.Run("Error_stops_walk", func(t *testing.T) {
+		errStop := errors.New("stop")
+		paths := []string{}
+
+		err := Walk(order, func(path string, v reflect.Value) error {
+			paths = append(paths, path)
+			if path == "Lines[0].SKU" {
+				return errStop
+			}
+			return nil
+		})
+
+		assert.ErrorIs(t, err, errStop)
+		assert.Equal(t, []string{"ID", "Lines", "Lines[0]", "Lines[0].SKU"}, paths)
+	})
+}
@@ -0,0 +1,128 @@
+// Package set provides Set, a hash set of comparable values with the usual algebra operations.
+package set
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Set is a collection of unique values. The zero value is a nil set: it can be read but not added to;
+// use New or FromSlice.
+type Set[T comparable] map[T]struct{}
+
+// New creates a set holding the given items.
+func New[T comparable](items ...T) Set[T] {
+	return FromSlice(items)
+}
+
+// FromSlice creates a set holding the items of the list.
+func FromSlice[T comparable](source []T) Set[T] {
+	result := make(Set[T], len(source))
+	result.Add(source...)
+	return result
+}
+
+// Add inserts the items into the set.
+func (s Set[T]) Add(items ...T) {
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+}
+
+// Remove deletes the items from the set.
+func (s Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s, item)
+	}
+}
+
+// Contains reports whether the item is in the set.
+func (s Set[T]) Contains(item T) bool {
+	_, exists := s[item]
+	return exists
+}
+
+// Len returns the number of items.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Union returns a new set with the items of either set.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := make(Set[T], max(len(s), len(other)))
+	for item := range s {
+		result[item] = struct{}{}
+	}
+	for item := range other {
+		result[item] = struct{}{}
+	}
+	return result
+}
+
+// Intersect returns a new set with the items present in both sets.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+	result := make(Set[T])
+	for item := range small {
+		if large.Contains(item) {
+			result[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// Difference returns a new set with the items of s that are not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := make(Set[T])
+	for item := range s {
+		if !other.Contains(item) {
+			result[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// SymmetricDifference returns a new set with the items present in exactly one of the sets.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := s.Difference(other)
+	for item := range other {
+		if !s.Contains(item) {
+			result[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubsetOf reports whether every item of s is in other.
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	for item := range s {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether both sets hold the same items.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubsetOf(other)
+}
+
+// ToSlice returns the items in unspecified order. Use Sorted for a deterministic order.
+func (s Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s))
+	for item := range s {
+		result = append(result, item)
+	}
+	return result
+}
+
+// Sorted returns the items of an ordered set in ascending order.
+func Sorted[T cmp.Ordered](s Set[T]) []T {
+	result := s.ToSlice()
+	slices.Sort(result)
+	return result
+}
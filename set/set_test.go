@@ -0,0 +1,49 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("Success_membership", func(t *testing.T) {
+		roles := New("admin", "editor")
+		roles.Add("viewer", "admin")
+		roles.Remove("editor")
+
+		assert.True(t, roles.Contains("admin"))
+		assert.False(t, roles.Contains("editor"))
+		assert.Equal(t, 2, roles.Len())
+		assert.Equal(t, []string{"admin", "viewer"}, Sorted(roles))
+	})
+
+	t.Run("Success_algebra", func(t *testing.T) {
+		a := FromSlice([]int{1, 2, 3, 4})
+		b := New(3, 4, 5)
+
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, Sorted(a.Union(b)))
+		assert.Equal(t, []int{3, 4}, Sorted(a.Intersect(b)))
+		assert.Equal(t, []int{1, 2}, Sorted(a.Difference(b)))
+		assert.Equal(t, []int{1, 2, 5}, Sorted(a.SymmetricDifference(b)))
+		assert.Equal(t, []int{1, 2, 3, 4}, Sorted(a))
+	})
+
+	t.Run("Success_comparisons", func(t *testing.T) {
+		a := New(1, 2)
+
+		assert.True(t, a.IsSubsetOf(New(1, 2, 3)))
+		assert.False(t, New(1, 2, 3).IsSubsetOf(a))
+		assert.True(t, a.Equal(FromSlice([]int{2, 1, 2})))
+		assert.False(t, a.Equal(New(1, 3)))
+	})
+
+	t.Run("Success_nil_set_reads", func(t *testing.T) {
+		var empty Set[string]
+
+		assert.False(t, empty.Contains("x"))
+		assert.Equal(t, 0, empty.Len())
+		assert.Empty(t, empty.ToSlice())
+		assert.Equal(t, []string{"x"}, Sorted(empty.Union(New("x"))))
+	})
+}
@@ -0,0 +1,68 @@
+package sketch
+
+import (
+	"fmt"
+	"math"
+
+	hashing "github.com/lumiluminousai/golang-fp-utility/hashing"
+)
+
+// BloomSet is a probabilistic set: MightContain never returns false for an added item,
+// but may return true for an item that was not added, at roughly the configured rate.
+type BloomSet[T comparable] struct {
+	bits   []uint64
+	size   uint64
+	hashes uint64
+}
+
+// NewBloomSet creates a BloomSet sized for the expected number of items and target false-positive rate.
+func NewBloomSet[T comparable](expectedItems int, falsePositiveRate float64) (*BloomSet[T], error) {
+	if expectedItems < 1 {
+		return nil, fmt.Errorf("bloomSet: expected items %d must be positive", expectedItems)
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		return nil, fmt.Errorf("bloomSet: false positive rate %v is out of range (0, 1)", falsePositiveRate)
+	}
+	n := float64(expectedItems)
+	size := uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashes := uint64(math.Max(1, math.Round(float64(size)/n*math.Ln2)))
+	return &BloomSet[T]{bits: make([]uint64, (size+63)/64), size: size, hashes: hashes}, nil
+}
+
+// Add records an item. It fails only when the item cannot be hashed.
+func (b *BloomSet[T]) Add(item T) error {
+	h1, h2, err := b.baseHashes(item)
+	if err != nil {
+		return err
+	}
+	for i := uint64(0); i < b.hashes; i++ {
+		position := (h1 + i*h2) % b.size
+		b.bits[position/64] |= 1 << (position % 64)
+	}
+	return nil
+}
+
+// MightContain reports whether the item may have been added. It can be passed directly to Filter.
+// Items that cannot be hashed could never have been added and report false.
+func (b *BloomSet[T]) MightContain(item T) bool {
+	h1, h2, err := b.baseHashes(item)
+	if err != nil {
+		return false
+	}
+	for i := uint64(0); i < b.hashes; i++ {
+		position := (h1 + i*h2) % b.size
+		if b.bits[position/64]&(1<<(position%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// baseHashes derives the two hashes combined by double hashing into the bit positions of an item.
+func (b *BloomSet[T]) baseHashes(item T) (uint64, uint64, error) {
+	hash, err := hashing.HashComparable(item)
+	if err != nil {
+		return 0, 0, err
+	}
+	return mix64(hash), mix64(hash^0x9e3779b97f4a7c15) | 1, nil
+}
@@ -0,0 +1,60 @@
+package sketch
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
+)
+
+func TestBloomSet(t *testing.T) {
+	t.Run("Success_no_false_negatives", func(t *testing.T) {
+		bloom, err := NewBloomSet[string](1000, 0.01)
+		assert.NoError(t, err)
+
+		for i := 0; i < 1000; i++ {
+			assert.NoError(t, bloom.Add("sku-"+strconv.Itoa(i)))
+		}
+		for i := 0; i < 1000; i++ {
+			assert.True(t, bloom.MightContain("sku-"+strconv.Itoa(i)))
+		}
+	})
+
+	t.Run("Success_false_positive_rate", func(t *testing.T) {
+		bloom, err := NewBloomSet[int](10000, 0.01)
+		assert.NoError(t, err)
+		for i := 0; i < 10000; i++ {
+			assert.NoError(t, bloom.Add(i))
+		}
+
+		falsePositives := 0
+		for i := 10000; i < 20000; i++ {
+			if bloom.MightContain(i) {
+				falsePositives++
+			}
+		}
+
+		assert.Less(t, falsePositives, 300)
+	})
+
+	t.Run("Success_with_filter", func(t *testing.T) {
+		bloom, err := NewBloomSet[int](10, 0.001)
+		assert.NoError(t, err)
+		assert.NoError(t, bloom.Add(2))
+		assert.NoError(t, bloom.Add(4))
+
+		result := collection.Filter([]int{1, 2, 3, 4}, bloom.MightContain)
+
+		assert.Equal(t, []int{2, 4}, result)
+	})
+
+	t.Run("Error_invalid_parameters", func(t *testing.T) {
+		_, err := NewBloomSet[int](0, 0.01)
+		assert.EqualError(t, err, "bloomSet: expected items 0 must be positive")
+
+		_, err = NewBloomSet[int](10, 1)
+		assert.EqualError(t, err, "bloomSet: false positive rate 1 is out of range (0, 1)")
+	})
+}
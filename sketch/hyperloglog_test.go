@@ -0,0 +1,61 @@
+package sketch
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	t.Run("Success_estimate_within_error", func(t *testing.T) {
+		hll, err := NewHyperLogLog[string](14)
+		assert.NoError(t, err)
+
+		for i := 0; i < 100000; i++ {
+			assert.NoError(t, hll.Add("user-"+strconv.Itoa(i%50000)))
+		}
+
+		estimate := float64(hll.Estimate())
+		assert.InDelta(t, 50000, estimate, 50000*0.03)
+	})
+
+	t.Run("Success_small_cardinality", func(t *testing.T) {
+		estimate, err := EstimateDistinct([]int{1, 2, 3, 3, 2, 1, 4}, 10)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(4), estimate)
+	})
+
+	t.Run("Success_empty", func(t *testing.T) {
+		estimate, err := EstimateDistinct([]int{}, 4)
+
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(0), estimate)
+	})
+
+	t.Run("Success_struct_items", func(t *testing.T) {
+		type key struct {
+			Country string
+			ID      int
+		}
+		items := []key{}
+		for i := 0; i < 1000; i++ {
+			items = append(items, key{Country: "TH", ID: i}, key{Country: "JP", ID: i})
+		}
+
+		estimate, err := EstimateDistinct(items, 12)
+
+		assert.NoError(t, err)
+		assert.Less(t, math.Abs(float64(estimate)-2000), 2000*0.05)
+	})
+
+	t.Run("Error_precision_out_of_range", func(t *testing.T) {
+		_, err := NewHyperLogLog[int](3)
+		assert.EqualError(t, err, "hyperLogLog: precision 3 is out of range [4, 16]")
+
+		_, err = EstimateDistinct([]int{1}, 17)
+		assert.EqualError(t, err, "hyperLogLog: precision 17 is out of range [4, 16]")
+	})
+}
@@ -0,0 +1,178 @@
+// Package stream provides Seq, a lazy sequence with chainable operations. Unlike the eager functions of
+// the collection package, a chain of stream operations allocates no intermediate slices: each item
+// flows through every stage before the next one is read, and short-circuiting stages such as Take stop
+// reading the source early.
+package stream
+
+import (
+	"iter"
+	"math"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
+)
+
+// Seq is a lazy sequence. It is an iter.Seq, so it can be ranged over directly.
+type Seq[T any] iter.Seq[T]
+
+// Of returns a sequence of the given items.
+func Of[T any](items ...T) Seq[T] {
+	return FromSlice(items)
+}
+
+// FromSlice returns a sequence over the items of the list. The list is read lazily, not copied.
+func FromSlice[T any](source []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range source {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq wraps a standard iterator.
+func FromSeq[T any](seq iter.Seq[T]) Seq[T] {
+	return Seq[T](seq)
+}
+
+// Iter returns the sequence as a standard iterator.
+func (s Seq[T]) Iter() iter.Seq[T] {
+	return iter.Seq[T](s)
+}
+
+// Filter keeps the items satisfying the predicate.
+func (s Seq[T]) Filter(filterFunc func(item T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s {
+			if filterFunc(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Take yields at most the first n items.
+func (s Seq[T]) Take(n int) Seq[T] {
+	return Seq[T](collection.TakeSeq(iter.Seq[T](s), n))
+}
+
+// Drop skips the first n items.
+func (s Seq[T]) Drop(n int) Seq[T] {
+	return func(yield func(T) bool) {
+		skipped := 0
+		for item := range s {
+			if skipped < n {
+				skipped++
+				continue
+			}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile yields items while the predicate holds and stops at the first item that fails it.
+func (s Seq[T]) TakeWhile(predicate func(item T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s {
+			if !predicate(item) || !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Paginate applies collection.WithOffset and collection.WithLimit options, e.g. to preview the first rows
+// of a transformation.
+func (s Seq[T]) Paginate(opts ...collection.SliceOption) Seq[T] {
+	start, end := collection.ResolveSliceOptions(math.MaxInt, opts...)
+	if end == math.MaxInt {
+		return s.Drop(start)
+	}
+	return s.Drop(start).Take(end - start)
+}
+
+// ForEach executes the action for each item.
+func (s Seq[T]) ForEach(action func(item T)) {
+	for item := range s {
+		action(item)
+	}
+}
+
+// ToSlice collects the items into a new list.
+func (s Seq[T]) ToSlice() []T {
+	result := []T{}
+	for item := range s {
+		result = append(result, item)
+	}
+	return result
+}
+
+// ForEachBatch groups the items into batches of up to size items and passes each batch to the action,
+// e.g. for bulk inserts. Batch buffers are taken from the pool and returned to it once the action
+// returns, so the action must not retain the batch; a nil pool allocates a buffer per call instead.
+// Sizes below 1 are treated as 1.
+func (s Seq[T]) ForEachBatch(size int, pool *collection.BufferPool[T], action func(batch []T)) {
+	size = max(size, 1)
+	if pool == nil {
+		pool = collection.NewBufferPool[T]()
+	}
+	batch := pool.Get(size)
+	defer func() { pool.Put(batch) }()
+	for item := range s {
+		batch = append(batch, item)
+		if len(batch) == size {
+			action(batch)
+			batch = batch[:0]
+		}
+	}
+	if len(batch) > 0 {
+		action(batch)
+	}
+}
+
+// Count consumes the sequence and returns the number of items.
+func (s Seq[T]) Count() int {
+	count := 0
+	for range s {
+		count++
+	}
+	return count
+}
+
+// Map lazily applies a transformation function to each item.
+func Map[T1 any, T2 any](s Seq[T1], transform func(item T1) T2) Seq[T2] {
+	return func(yield func(T2) bool) {
+		for item := range s {
+			if !yield(transform(item)) {
+				return
+			}
+		}
+	}
+}
+
+// Distinct yields each item the first time it appears. It remembers every item seen so far.
+func Distinct[T comparable](s Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for item := range s {
+			if _, exists := seen[item]; exists {
+				continue
+			}
+			seen[item] = struct{}{}
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce folds the sequence into a single value using an accumulator function.
+func Reduce[T any, A any](s Seq[T], reduceFunc func(acc A, item T) A, initialValue A) A {
+	acc := initialValue
+	for item := range s {
+		acc = reduceFunc(acc, item)
+	}
+	return acc
+}
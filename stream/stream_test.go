@@ -0,0 +1,94 @@
+package stream
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	collection "github.com/lumiluminousai/golang-fp-utility/collection"
+)
+
+func TestSeq(t *testing.T) {
+	t.Run("Success_chain", func(t *testing.T) {
+		result := Map(Of(1, 2, 3, 4, 5, 6).Filter(func(n int) bool { return n%2 == 0 }), strconv.Itoa).ToSlice()
+
+		assert.Equal(t, []string{"2", "4", "6"}, result)
+	})
+
+	t.Run("Success_lazy_stops_reading_source", func(t *testing.T) {
+		read := 0
+		source := Map(FromSeq(collection.Iterate(1, func(n int) int { return n + 1 })), func(n int) int {
+			read++
+			return n * n
+		})
+
+		result := source.Filter(func(n int) bool { return n%2 == 1 }).Take(3).ToSlice()
+
+		assert.Equal(t, []int{1, 9, 25}, result)
+		assert.Equal(t, 5, read)
+	})
+
+	t.Run("Success_drop_takeWhile_distinct", func(t *testing.T) {
+		source := FromSlice([]int{1, 1, 2, 3, 3, 4, 9, 2})
+
+		assert.Equal(t, []int{2, 3, 3, 4, 9, 2}, source.Drop(2).ToSlice())
+		assert.Equal(t, []int{1, 1, 2, 3, 3}, source.TakeWhile(func(n int) bool { return n < 4 }).ToSlice())
+		assert.Equal(t, []int{1, 2, 3, 4, 9}, Distinct(source).ToSlice())
+		assert.Empty(t, source.Drop(100).ToSlice())
+		assert.Empty(t, source.Take(0).ToSlice())
+	})
+
+	t.Run("Success_paginate", func(t *testing.T) {
+		source := Of(1, 2, 3, 4, 5)
+
+		assert.Equal(t, []int{2, 3}, source.Paginate(collection.WithOffset(1), collection.WithLimit(2)).ToSlice())
+		assert.Equal(t, []int{4, 5}, source.Paginate(collection.WithOffset(3)).ToSlice())
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, source.Paginate().ToSlice())
+	})
+
+	t.Run("Success_terminals", func(t *testing.T) {
+		source := Of("a", "bb", "ccc")
+		total := 0
+
+		source.ForEach(func(s string) { total += len(s) })
+
+		assert.Equal(t, 6, total)
+		assert.Equal(t, 3, source.Count())
+		assert.Equal(t, 6, Reduce(source, func(acc int, s string) int { return acc + len(s) }, 0))
+	})
+
+	t.Run("Success_forEachBatch_pooled", func(t *testing.T) {
+		pool := collection.NewBufferPool[int]()
+		batches := [][]int{}
+
+		Of(1, 2, 3, 4, 5).Filter(func(n int) bool { return n != 3 }).ForEachBatch(2, pool, func(batch []int) {
+			batches = append(batches, collection.CloneList(batch))
+		})
+
+		assert.Equal(t, [][]int{{1, 2}, {4, 5}}, batches)
+		assert.Empty(t, pool.Get(2))
+	})
+
+	t.Run("Success_forEachBatch_without_pool", func(t *testing.T) {
+		batches := [][]string{}
+
+		Of("a", "b", "c").ForEachBatch(0, nil, func(batch []string) {
+			batches = append(batches, collection.CloneList(batch))
+		})
+
+		assert.Equal(t, [][]string{{"a"}, {"b"}, {"c"}}, batches)
+	})
+}
+
+func BenchmarkSeqChain(b *testing.B) {
+	source := make([]int, 10000)
+	for i := range source {
+		source[i] = i
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = Reduce(Map(FromSlice(source).Filter(func(n int) bool { return n%3 == 0 }), func(n int) int { return n * 2 }),
+			func(acc int, n int) int { return acc + n }, 0)
+	}
+}